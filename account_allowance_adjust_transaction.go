@@ -220,6 +220,19 @@ func (tx *AccountAllowanceAdjustTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *AccountAllowanceAdjustTransaction) SignWithSigner(signer Signer) *AccountAllowanceAdjustTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this AccountAllowanceAdjustTransaction.
+func (tx *AccountAllowanceAdjustTransaction) SetSigningKeys(keys []PublicKey) *AccountAllowanceAdjustTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 func (tx *AccountAllowanceAdjustTransaction) Freeze() (*AccountAllowanceAdjustTransaction, error) {
 	return tx.FreezeWith(nil)
 }
@@ -295,6 +308,12 @@ func (tx *AccountAllowanceAdjustTransaction) SetMaxBackoff(max time.Duration) *A
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *AccountAllowanceAdjustTransaction) SetSingleNode(singleNode bool) *AccountAllowanceAdjustTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 func (tx *AccountAllowanceAdjustTransaction) SetMinBackoff(min time.Duration) *AccountAllowanceAdjustTransaction {
 	tx.Transaction.SetMinBackoff(min)
 	return tx
@@ -285,6 +285,19 @@ func (tx *AccountAllowanceApproveTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *AccountAllowanceApproveTransaction) SignWithSigner(signer Signer) *AccountAllowanceApproveTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this AccountAllowanceApproveTransaction.
+func (tx *AccountAllowanceApproveTransaction) SetSigningKeys(keys []PublicKey) *AccountAllowanceApproveTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *AccountAllowanceApproveTransaction) AddSignature(publicKey PublicKey, signature []byte) *AccountAllowanceApproveTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -372,6 +385,12 @@ func (tx *AccountAllowanceApproveTransaction) SetMaxBackoff(max time.Duration) *
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *AccountAllowanceApproveTransaction) SetSingleNode(singleNode bool) *AccountAllowanceApproveTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the min back off for this AccountAllowanceApproveTransaction.
 func (tx *AccountAllowanceApproveTransaction) SetMinBackoff(min time.Duration) *AccountAllowanceApproveTransaction {
 	tx.Transaction.SetMinBackoff(min)
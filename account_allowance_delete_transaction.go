@@ -168,6 +168,19 @@ func (tx *AccountAllowanceDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *AccountAllowanceDeleteTransaction) SignWithSigner(signer Signer) *AccountAllowanceDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this AccountAllowanceDeleteTransaction.
+func (tx *AccountAllowanceDeleteTransaction) SetSigningKeys(keys []PublicKey) *AccountAllowanceDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *AccountAllowanceDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *AccountAllowanceDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -252,6 +265,12 @@ func (tx *AccountAllowanceDeleteTransaction) SetMaxBackoff(max time.Duration) *A
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *AccountAllowanceDeleteTransaction) SetSingleNode(singleNode bool) *AccountAllowanceDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the min back off for this AccountAllowanceDeleteTransaction.
 func (tx *AccountAllowanceDeleteTransaction) SetMinBackoff(min time.Duration) *AccountAllowanceDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -94,7 +94,7 @@ func (q *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
 // Execute executes the query with the provided client
 func (q *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
 	if client == nil {
-		return AccountBalance{}, errNoClientProvided
+		return AccountBalance{}, ErrNoClientProvided
 	}
 
 	var err error
@@ -112,6 +112,7 @@ func (q *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
 	if err != nil {
 		return AccountBalance{}, err
 	}
+	q.responseHeader = _ResponseHeaderFromProtobuf(resp.(*services.Response).GetCryptogetAccountBalance().GetHeader())
 
 	return _AccountBalanceFromProtobuf(resp.(*services.Response).GetCryptogetAccountBalance()), nil
 }
@@ -122,6 +123,12 @@ func (q *AccountBalanceQuery) SetMaxQueryPayment(maxPayment Hbar) *AccountBalanc
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *AccountBalanceQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *AccountBalanceQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this query.
 func (q *AccountBalanceQuery) SetQueryPayment(paymentAmount Hbar) *AccountBalanceQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -146,6 +153,12 @@ func (q *AccountBalanceQuery) SetMaxBackoff(max time.Duration) *AccountBalanceQu
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *AccountBalanceQuery) SetSingleNode(singleNode bool) *AccountBalanceQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *AccountBalanceQuery) SetMinBackoff(min time.Duration) *AccountBalanceQuery {
 	q.Query.SetMinBackoff(min)
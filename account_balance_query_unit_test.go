@@ -176,3 +176,40 @@ func TestUnitAccountBalanceQueryMock(t *testing.T) {
 	_, err := query.Execute(client)
 	require.NoError(t, err)
 }
+
+func TestUnitClientAccountExists(t *testing.T) {
+	t.Parallel()
+
+	balance := func(status services.ResponseCodeEnum) *services.Response {
+		return &services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: status, ResponseType: services.ResponseType_ANSWER_ONLY},
+					Balance: 2000,
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{
+		balance(services.ResponseCodeEnum_OK),
+		balance(services.ResponseCodeEnum_INVALID_ACCOUNT_ID),
+		balance(services.ResponseCodeEnum_ACCOUNT_DELETED),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	exists, err := client.AccountExists(AccountID{Account: 1800})
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = client.AccountExists(AccountID{Account: 1801})
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = client.AccountExists(AccountID{Account: 1802})
+	var precheckErr ErrHederaPreCheckStatus
+	require.ErrorAs(t, err, &precheckErr)
+	require.Equal(t, StatusAccountDeleted, precheckErr.Status)
+	require.False(t, exists)
+}
@@ -49,13 +49,24 @@ type AccountCreateTransaction struct {
 	stakedNodeID                  *int64
 	declineReward                 bool
 	alias                         []byte
+	minAutoRenewPeriod            time.Duration
+	maxAutoRenewPeriod            time.Duration
 }
 
+// The auto renew period bounds AccountCreateTransaction validates against by default, matching the network's
+// ledger.autoRenewPeriod.minDuration and ledger.autoRenewPeriod.maxDuration.
+const (
+	DefaultMinAutoRenewPeriod = 24 * time.Hour
+	DefaultMaxAutoRenewPeriod = 8000001 * time.Second
+)
+
 // NewAccountCreateTransaction creates an AccountCreateTransaction transaction which can be used to construct and
 // execute a Crypto Create Transaction.
 func NewAccountCreateTransaction() *AccountCreateTransaction {
 	tx := AccountCreateTransaction{
-		Transaction: _NewTransaction(),
+		Transaction:        _NewTransaction(),
+		minAutoRenewPeriod: DefaultMinAutoRenewPeriod,
+		maxAutoRenewPeriod: DefaultMaxAutoRenewPeriod,
 	}
 
 	tx.SetAutoRenewPeriod(7890000 * time.Second)
@@ -89,6 +100,8 @@ func _AccountCreateTransactionFromProtobuf(tx Transaction, pb *services.Transact
 		stakedAccountID:               stakeAccountID,
 		stakedNodeID:                  stakedNodeID,
 		declineReward:                 pb.GetCryptoCreateAccount().GetDeclineReward(),
+		minAutoRenewPeriod:            DefaultMinAutoRenewPeriod,
+		maxAutoRenewPeriod:            DefaultMaxAutoRenewPeriod,
 	}
 
 	if pb.GetCryptoCreateAccount().GetAlias() != nil {
@@ -106,6 +119,18 @@ func (tx *AccountCreateTransaction) SetKey(key Key) *AccountCreateTransaction {
 	return tx
 }
 
+// SetKeyFromProtobuf sets the key like SetKey, from a protobuf Key received from elsewhere. If the key is not well
+// formed, see KeyFromProtobuf, the key is left unchanged and the reason is returned.
+func (tx *AccountCreateTransaction) SetKeyFromProtobuf(pbKey *services.Key) (*AccountCreateTransaction, error) {
+	tx._RequireNotFrozen()
+	key, err := KeyFromProtobuf(pbKey)
+	if err != nil {
+		return tx, err
+	}
+	tx.key = key
+	return tx, nil
+}
+
 // GetKey returns the key that must sign each transfer out of the account.
 func (tx *AccountCreateTransaction) GetKey() (Key, error) {
 	return tx.key, nil
@@ -158,6 +183,22 @@ func (tx *AccountCreateTransaction) GetAutoRenewPeriod() time.Duration {
 	return time.Duration(0)
 }
 
+// SetAutoRenewPeriodBounds sets the inclusive range the auto renew period is validated against on freeze,
+// for networks configured differently than DefaultMinAutoRenewPeriod and DefaultMaxAutoRenewPeriod.
+// An auto renew period outside of it fails the freeze with ErrAutoRenewPeriodOutOfRange instead of
+// being rejected by the network with AUTORENEW_DURATION_NOT_IN_RANGE.
+func (tx *AccountCreateTransaction) SetAutoRenewPeriodBounds(min time.Duration, max time.Duration) *AccountCreateTransaction {
+	tx._RequireNotFrozen()
+	tx.minAutoRenewPeriod = min
+	tx.maxAutoRenewPeriod = max
+	return tx
+}
+
+// GetAutoRenewPeriodBounds returns the inclusive range the auto renew period is validated against on freeze.
+func (tx *AccountCreateTransaction) GetAutoRenewPeriodBounds() (time.Duration, time.Duration) {
+	return tx.minAutoRenewPeriod, tx.maxAutoRenewPeriod
+}
+
 // Deprecated
 // SetProxyAccountID sets the ID of the account to which this account is proxy staked. If proxyAccountID is not set,
 // is an invalid account, or is an account that isn't a _Node, then this account is automatically proxy staked to a _Node
@@ -294,6 +335,19 @@ func (tx *AccountCreateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *AccountCreateTransaction) SignWithSigner(signer Signer) *AccountCreateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this AccountCreateTransaction.
+func (tx *AccountCreateTransaction) SetSigningKeys(keys []PublicKey) *AccountCreateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *AccountCreateTransaction) AddSignature(publicKey PublicKey, signature []byte) *AccountCreateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -379,6 +433,12 @@ func (tx *AccountCreateTransaction) SetMaxBackoff(max time.Duration) *AccountCre
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *AccountCreateTransaction) SetSingleNode(singleNode bool) *AccountCreateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *AccountCreateTransaction) SetMinBackoff(min time.Duration) *AccountCreateTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -404,6 +464,19 @@ func (tx *AccountCreateTransaction) getName() string {
 	return "AccountCreateTransaction"
 }
 
+func (tx *AccountCreateTransaction) validateBeforeFreeze(client *Client) error {
+	if tx.autoRenewPeriod != nil &&
+		(*tx.autoRenewPeriod < tx.minAutoRenewPeriod || *tx.autoRenewPeriod > tx.maxAutoRenewPeriod) {
+		return ErrAutoRenewPeriodOutOfRange{
+			AutoRenewPeriod: *tx.autoRenewPeriod,
+			Min:             tx.minAutoRenewPeriod,
+			Max:             tx.maxAutoRenewPeriod,
+		}
+	}
+
+	return nil
+}
+
 func (tx *AccountCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -167,7 +167,7 @@ func TestUnitAccountCreateTransactionGet(t *testing.T) {
 		SetAccountMemo("").
 		SetReceiverSignatureRequired(true).
 		SetMaxAutomaticTokenAssociations(2).
-		SetAutoRenewPeriod(60 * time.Second).
+		SetAutoRenewPeriod(24 * time.Hour).
 		SetTransactionMemo("").
 		SetTransactionValidDuration(60 * time.Second).
 		Freeze()
@@ -253,7 +253,7 @@ func TestUnitAccountCreateTransactionProtoCheck(t *testing.T) {
 		SetMaxAutomaticTokenAssociations(2).
 		SetStakedAccountID(stackedAccountID).
 		SetDeclineStakingReward(true).
-		SetAutoRenewPeriod(60 * time.Second).
+		SetAutoRenewPeriod(24 * time.Hour).
 		SetTransactionMemo("").
 		SetTransactionValidDuration(60 * time.Second).
 		SetAlias(alias).
@@ -272,7 +272,7 @@ func TestUnitAccountCreateTransactionProtoCheck(t *testing.T) {
 	require.Equal(t, proto.StakedId.(*services.CryptoCreateTransactionBody_StakedAccountId).StakedAccountId.String(),
 		stackedAccountID._ToProtobuf().String())
 	require.Equal(t, proto.DeclineReward, true)
-	require.Equal(t, proto.AutoRenewPeriod.String(), _DurationToProtobuf(60*time.Second).String())
+	require.Equal(t, proto.AutoRenewPeriod.String(), _DurationToProtobuf(24*time.Hour).String())
 	require.Equal(t, hex.EncodeToString(proto.Alias), alias)
 }
 
@@ -305,7 +305,7 @@ func TestUnitAccountCreateTransactionCoverage(t *testing.T) {
 		SetStakedAccountID(account).
 		SetStakedNodeID(4).
 		SetDeclineStakingReward(true).
-		SetAutoRenewPeriod(60 * time.Second).
+		SetAutoRenewPeriod(24 * time.Hour).
 		SetTransactionMemo("").
 		SetTransactionValidDuration(60 * time.Second).
 		SetMaxTransactionFee(NewHbar(3)).
@@ -353,3 +353,99 @@ func TestUnitAccountCreateTransactionCoverage(t *testing.T) {
 		b.AddSignature(key.PublicKey(), sig)
 	}
 }
+
+func TestUnitAccountCreateTransactionAutoRenewPeriodBounds(t *testing.T) {
+	t.Parallel()
+
+	freeze := func(period time.Duration) error {
+		_, err := NewAccountCreateTransaction().
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 324})).
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetMaxAutomaticTokenAssociations(10).
+			SetAutoRenewPeriod(period).
+			Freeze()
+		return err
+	}
+
+	require.NoError(t, freeze(DefaultMinAutoRenewPeriod))
+	require.NoError(t, freeze(DefaultMaxAutoRenewPeriod))
+	require.NoError(t, freeze(7890000*time.Second))
+
+	err := freeze(DefaultMinAutoRenewPeriod - time.Second)
+	var rangeErr ErrAutoRenewPeriodOutOfRange
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, DefaultMinAutoRenewPeriod-time.Second, rangeErr.AutoRenewPeriod)
+	var freezeErr ErrFreezeFailed
+	require.ErrorAs(t, err, &freezeErr)
+
+	err = freeze(DefaultMaxAutoRenewPeriod + time.Second)
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, "auto renew period 2222h13m22s is not in range [24h0m0s, 2222h13m21s]", rangeErr.Error())
+
+	transaction := NewAccountCreateTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 324})).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAutoRenewPeriodBounds(time.Hour, 2*time.Hour).
+		SetAutoRenewPeriod(time.Hour)
+	min, max := transaction.GetAutoRenewPeriodBounds()
+	require.Equal(t, time.Hour, min)
+	require.Equal(t, 2*time.Hour, max)
+	_, err = transaction.Freeze()
+	require.NoError(t, err)
+
+	_, err = NewAccountCreateTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 324})).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAutoRenewPeriodBounds(time.Hour, 2*time.Hour).
+		SetAutoRenewPeriod(2*time.Hour + time.Second).
+		Freeze()
+	require.ErrorAs(t, err, &rangeErr)
+}
+
+func TestUnitAccountCreateTransactionSetKeyFromProtobuf(t *testing.T) {
+	t.Parallel()
+
+	pbKeys := make([]*services.Key, 0)
+	publicKeys := make([]PublicKey, 0)
+	for i := 0; i < 3; i++ {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		publicKeys = append(publicKeys, key.PublicKey())
+		pbKeys = append(pbKeys, key.PublicKey().ToProtobufKey())
+	}
+	thresholdKey := &services.Key{Key: &services.Key_ThresholdKey{ThresholdKey: &services.ThresholdKey{
+		Threshold: 2,
+		Keys:      &services.KeyList{Keys: pbKeys},
+	}}}
+
+	transactionID := TransactionIDGenerate(AccountID{Account: 1800})
+	tx, err := NewAccountCreateTransaction().
+		SetTransactionID(transactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetKeyFromProtobuf(thresholdKey)
+	require.NoError(t, err)
+	tx, err = tx.Freeze()
+	require.NoError(t, err)
+
+	key, err := tx.GetKey()
+	require.NoError(t, err)
+	assert.Equal(t, KeyListWithThreshold(2).AddAllPublicKeys(publicKeys).String(), key.String())
+	assert.Equal(t, thresholdKey.String(), tx.build().GetCryptoCreateAccount().GetKey().String())
+
+	validKey := KeyListWithThreshold(2).AddAllPublicKeys(publicKeys)
+	thresholdKey.GetThresholdKey().Threshold = 4
+	update, err := NewAccountUpdateTransaction().
+		SetTransactionID(transactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1234}).
+		SetKeyFromProtobuf(thresholdKey)
+	var badKey ErrBadKey
+	require.ErrorAs(t, err, &badKey)
+
+	// A rejected key doesn't stop the transaction from freezing once a valid key is set.
+	_, err = update.SetKey(validKey).Freeze()
+	require.NoError(t, err)
+
+	_, err = KeyFromProtobuf(&services.Key{Key: &services.Key_KeyList{KeyList: &services.KeyList{}}})
+	require.ErrorAs(t, err, &badKey)
+}
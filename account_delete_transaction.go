@@ -120,6 +120,19 @@ func (tx *AccountDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *AccountDeleteTransaction) SignWithSigner(signer Signer) *AccountDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this AccountDeleteTransaction.
+func (tx *AccountDeleteTransaction) SetSigningKeys(keys []PublicKey) *AccountDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *AccountDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *AccountDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -205,6 +218,12 @@ func (tx *AccountDeleteTransaction) SetMaxBackoff(max time.Duration) *AccountDel
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *AccountDeleteTransaction) SetSingleNode(singleNode bool) *AccountDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // GetMaxBackoff returns the maximum amount of time to wait between retries.
 func (tx *AccountDeleteTransaction) SetMinBackoff(min time.Duration) *AccountDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
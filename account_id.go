@@ -103,6 +103,16 @@ func AccountIDFromEvmAddress(shard uint64, realm uint64, aliasEvmAddress string)
 	}, nil
 }
 
+// AccountIDFromEvmPublicKey constructs an AccountID whose alias is the EVM address derived from an ECDSA public
+// key, usable as the recipient of a transfer. The key must be ECDSA.
+func AccountIDFromEvmPublicKey(publicKey PublicKey) (AccountID, error) {
+	if publicKey.ecdsaPublicKey == nil {
+		return AccountID{}, _NewErrBadKeyf("an EVM address can only be derived from an ECDSA public key")
+	}
+
+	return AccountIDFromEvmAddress(0, 0, publicKey.ToEvmAddress())
+}
+
 // Returns an AccountID with EvmPublic address for the use of HIP-583
 func AccountIDFromEvmPublicAddress(s string) (AccountID, error) {
 	return AccountIDFromString(s)
@@ -141,7 +151,7 @@ func (id *AccountID) ValidateChecksum(client *Client) error {
 			return err
 		}
 		if id.checksum == nil {
-			return errChecksumMissing
+			return ErrChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
 			networkName := NetworkNameOther
@@ -181,19 +191,15 @@ func (id AccountID) ToStringWithChecksum(client *Client) (string, error) {
 	if id.AliasKey != nil {
 		return "", errors.New("Account ID contains alias key, unable get checksum")
 	}
-	if client.GetNetworkName() == nil && client.GetLedgerID() == nil {
-		return "", errNetworkNameMissing
-	}
-	var checksum _ParseAddressResult
-	var err error
-	if client.network.ledgerID != nil {
-		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Account))
+	if id.AliasEvmAddress != nil {
+		return "", errors.New("Account ID contains EVM address alias, unable get checksum")
 	}
+	checksum, err := _ChecksumForID(client, id.Shard, id.Realm, id.Account)
 	if err != nil {
 		return "", err
 	}
 
-	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Account, checksum.correctChecksum), nil
+	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Account, checksum), nil
 }
 
 // GetChecksum Retrieve just the checksum
@@ -326,7 +332,7 @@ func (id AccountID) ToBytes() []byte {
 // AccountIDFromBytes converts wire-format encoding to Account ID
 func AccountIDFromBytes(data []byte) (AccountID, error) {
 	if data == nil {
-		return AccountID{}, errByteArrayNull
+		return AccountID{}, ErrByteArrayNull
 	}
 	pb := services.AccountID{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -344,19 +350,19 @@ const (
 	EvmAddress
 )
 
-func (id *AccountID) _MirrorNodeRequest(client *Client, populateType string) (map[string]interface{}, error) {
+// _MirrorNodeRestURL returns the base URL of the REST API of the client's first mirror node.
+func _MirrorNodeRestURL(client *Client) (string, error) {
 	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return nil, errors.New("mirror node is not set")
+		return "", errors.New("mirror node is not set")
 	}
 
 	mirrorUrl := client.GetMirrorNetwork()[0]
 	index := strings.Index(mirrorUrl, ":")
 	if index == -1 {
-		return nil, errors.New("invalid mirrorUrl format")
+		return "", errors.New("invalid mirrorUrl format")
 	}
 	mirrorUrl = mirrorUrl[:index]
 
-	var url string
 	protocol := "https"
 	port := ""
 
@@ -365,10 +371,20 @@ func (id *AccountID) _MirrorNodeRequest(client *Client, populateType string) (ma
 		port = ":5551"
 	}
 
+	return fmt.Sprintf("%s://%s%s", protocol, mirrorUrl, port), nil
+}
+
+func (id *AccountID) _MirrorNodeRequest(client *Client, populateType string) (map[string]interface{}, error) {
+	baseUrl, err := _MirrorNodeRestURL(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var url string
 	if populateType == "account" {
-		url = fmt.Sprintf("%s://%s%s/api/v1/accounts/%s", protocol, mirrorUrl, port, hex.EncodeToString(*id.AliasEvmAddress))
+		url = fmt.Sprintf("%s/api/v1/accounts/%s", baseUrl, hex.EncodeToString(*id.AliasEvmAddress))
 	} else {
-		url = fmt.Sprintf("%s://%s%s/api/v1/accounts/%s", protocol, mirrorUrl, port, id.String())
+		url = fmt.Sprintf("%s/api/v1/accounts/%s", baseUrl, id.String())
 	}
 
 	resp, err := http.Get(url) // #nosec
@@ -429,6 +445,126 @@ func (id *AccountID) PopulateEvmAddress(client *Client) error {
 	return nil
 }
 
+// TokenRelationshipsPage is one page of an account's token relationships listed by the mirror node.
+type TokenRelationshipsPage struct {
+	Relationships []TokenRelationship
+	// Next is passed back to GetTokenRelationships to fetch the following page. It is empty on the last page.
+	Next string
+}
+
+// GetTokenRelationships lists up to limit token relationships of the account from the mirror node, starting
+// from the page next points at, or from the first page when next is empty. A limit of 0 uses the mirror node's
+// default page size. AccountInfoQuery no longer returns token relationships, so they are paged through here.
+func (id AccountID) GetTokenRelationships(client *Client, limit int, next string) (TokenRelationshipsPage, error) {
+	if client == nil {
+		return TokenRelationshipsPage{}, ErrNoClientProvided
+	}
+
+	baseUrl, err := _MirrorNodeRestURL(client)
+	if err != nil {
+		return TokenRelationshipsPage{}, err
+	}
+
+	return id._GetTokenRelationships(baseUrl, limit, next)
+}
+
+func (id AccountID) _GetTokenRelationships(baseUrl string, limit int, next string) (TokenRelationshipsPage, error) {
+	if next == "" {
+		next = fmt.Sprintf("/api/v1/accounts/%s/tokens", id.String())
+		if limit > 0 {
+			next += fmt.Sprintf("?limit=%d", limit)
+		}
+	} else if !strings.HasPrefix(next, "/api/v1/accounts/") {
+		return TokenRelationshipsPage{}, fmt.Errorf("invalid token relationships page %q", next)
+	}
+
+	resp, err := http.Get(baseUrl + next) // #nosec
+	if err != nil {
+		return TokenRelationshipsPage{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return TokenRelationshipsPage{}, fmt.Errorf("mirror node returned %s listing token relationships", resp.Status)
+	}
+
+	var result struct {
+		Tokens []struct {
+			TokenID              string `json:"token_id"`
+			Balance              uint64 `json:"balance"`
+			Decimals             uint32 `json:"decimals"`
+			FreezeStatus         string `json:"freeze_status"`
+			KycStatus            string `json:"kyc_status"`
+			AutomaticAssociation bool   `json:"automatic_association"`
+		} `json:"tokens"`
+		Links struct {
+			Next *string `json:"next"`
+		} `json:"links"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return TokenRelationshipsPage{}, err
+	}
+
+	page := TokenRelationshipsPage{Relationships: make([]TokenRelationship, len(result.Tokens))}
+	for i, token := range result.Tokens {
+		tokenID, err := TokenIDFromString(token.TokenID)
+		if err != nil {
+			return TokenRelationshipsPage{}, err
+		}
+
+		page.Relationships[i] = TokenRelationship{
+			TokenID:              tokenID,
+			Balance:              token.Balance,
+			KycStatus:            _MirrorTokenStatus(token.KycStatus, "GRANTED", "REVOKED"),
+			FreezeStatus:         _MirrorTokenStatus(token.FreezeStatus, "FROZEN", "UNFROZEN"),
+			Decimals:             token.Decimals,
+			AutomaticAssociation: token.AutomaticAssociation,
+		}
+	}
+	if result.Links.Next != nil {
+		page.Next = *result.Links.Next
+	}
+
+	return page, nil
+}
+
+// _MirrorTokenStatus maps a mirror node KYC or freeze status to true or false, or nil when it doesn't apply.
+func _MirrorTokenStatus(status string, trueStatus string, falseStatus string) *bool {
+	var value bool
+	switch status {
+	case trueStatus:
+		value = true
+	case falseStatus:
+		value = false
+	default:
+		return nil
+	}
+
+	return &value
+}
+
+// ResolveAlias gets the numeric `AccountId` of an alias `AccountId` (one with `AliasKey` or `AliasEvmAddress` set)
+// from the network, using an AccountInfoQuery. The result can be cached by the caller, since an alias maps
+// to a single account once the account is created. Account IDs without an alias are returned unchanged.
+func (id AccountID) ResolveAlias(client *Client) (AccountID, error) {
+	if id.AliasKey == nil && id.AliasEvmAddress == nil {
+		return id, nil
+	}
+
+	info, err := NewAccountInfoQuery().
+		SetAccountID(id).
+		Execute(client)
+	if err != nil {
+		return AccountID{}, err
+	}
+
+	return AccountID{
+		Shard:   info.AccountID.Shard,
+		Realm:   info.AccountID.Realm,
+		Account: info.AccountID.Account,
+	}, nil
+}
+
 // Compare returns 0 if the two AccountID are identical, -1 if not.
 func (id AccountID) Compare(given AccountID) int {
 	if id.Shard > given.Shard { //nolint
@@ -24,8 +24,13 @@ package hedera
  */
 
 import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stretchr/testify/require"
@@ -111,6 +116,29 @@ func TestUnitAccountIDEvm(t *testing.T) {
 	require.Equal(t, id.String(), "0.0.0011223344556677889900112233445566778899")
 }
 
+func TestUnitAccountIDFromEvmPublicKey(t *testing.T) {
+	t.Parallel()
+
+	byt, err := hex.DecodeString("03af80b90d25145da28c583359beb47b21796b2fe1a23c1511e443e7a64dfdb27d")
+	require.NoError(t, err)
+	key, err := PublicKeyFromBytesECDSA(byt)
+	require.NoError(t, err)
+
+	id, err := AccountIDFromEvmPublicKey(key)
+	require.NoError(t, err)
+	require.Equal(t, "0.0.627306090abab3a6e1400e9345bc60c78a8bef57", id.String())
+	require.Equal(t, key.ToEthereumAddress(), hex.EncodeToString(*id.AliasEvmAddress))
+
+	transfer := NewTransferTransaction().AddHbarTransfer(id, NewHbar(1))
+	require.Contains(t, transfer.GetHbarTransfers(), id)
+
+	edKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	_, err = AccountIDFromEvmPublicKey(edKey.PublicKey())
+	var badKeyErr ErrBadKey
+	require.ErrorAs(t, err, &badKeyErr)
+}
+
 func TestUnitAccountIDPopulateFailForWrongMirrorHost(t *testing.T) {
 	t.Parallel()
 
@@ -181,3 +209,149 @@ func TestUnitAccountIDPopulateEvmFailWithNoMirrorNetwork(t *testing.T) {
 	err = id.PopulateEvmAddress(client)
 	require.Error(t, err)
 }
+
+func TestUnitAccountIDResolveAlias(t *testing.T) {
+	t.Parallel()
+
+	aliasKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	alias := aliasKey.PublicKey().ToAccountID(0, 0)
+
+	call := func(request *services.Query) *services.Response {
+		query := request.Query.(*services.Query_CryptoGetInfo).CryptoGetInfo
+		require.Equal(t, alias._ToProtobuf().String(), query.AccountID.String())
+
+		return &services.Response{
+			Response: &services.Response_CryptoGetInfo{
+				CryptoGetInfo: &services.CryptoGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountInfo: &services.CryptoGetInfoResponse_AccountInfo{
+						AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1234}},
+						Key:       aliasKey.PublicKey()._ToProtoKey(),
+					},
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_CryptoGetInfo{
+				CryptoGetInfo: &services.CryptoGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER, Cost: 2},
+				},
+			},
+		},
+		call,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	resolved, err := (*alias).ResolveAlias(client)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 1234}, resolved)
+
+	numeric, err := AccountID{Account: 5}.ResolveAlias(nil)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 5}, numeric)
+}
+
+func TestUnitAccountIDToStringWithChecksum(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetLedgerID(*NewLedgerIDMainnet())
+
+	id := AccountID{Account: 123}
+	withChecksum, err := id.ToStringWithChecksum(client)
+	require.NoError(t, err)
+	require.Equal(t, "0.0.123-vfmkw", withChecksum)
+
+	parsed, err := AccountIDFromString(withChecksum)
+	require.NoError(t, err)
+	require.Equal(t, "vfmkw", *parsed.GetChecksum())
+	require.NoError(t, parsed.ValidateChecksum(client))
+	require.Nil(t, id.GetChecksum())
+
+	tokenWithChecksum, err := TokenID{Token: 123}.ToStringWithChecksum(*client)
+	require.NoError(t, err)
+	require.Equal(t, withChecksum, tokenWithChecksum)
+
+	noNetwork := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	_, err = id.ToStringWithChecksum(noNetwork)
+	require.ErrorIs(t, err, ErrNetworkNameMissing)
+	_, err = TopicID{Topic: 123}.ToStringWithChecksum(*noNetwork)
+	require.ErrorIs(t, err, ErrNetworkNameMissing)
+	_, err = id.ToStringWithChecksum(nil)
+	require.ErrorIs(t, err, ErrNetworkNameMissing)
+}
+
+func TestUnitAccountIDGetTokenRelationships(t *testing.T) {
+	t.Parallel()
+
+	const pageSize = 2
+	tokenCount := 5
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/accounts/0.0.1800/tokens", r.URL.Path)
+		assert.Equal(t, fmt.Sprint(pageSize), r.URL.Query().Get("limit"))
+
+		start := 1
+		if after := r.URL.Query().Get("token.id"); after != "" {
+			_, err := fmt.Sscanf(after, "gt:0.0.%d", &start)
+			assert.NoError(t, err)
+			start++
+		}
+
+		tokens := ""
+		last := start
+		for i := start; i < start+pageSize && i <= tokenCount; i++ {
+			if tokens != "" {
+				tokens += ","
+			}
+			tokens += fmt.Sprintf(`{"token_id":"0.0.%d","balance":%d,"decimals":2,"freeze_status":"UNFROZEN","kyc_status":"NOT_APPLICABLE","automatic_association":true}`, i, i*100)
+			last = i
+		}
+		next := "null"
+		if last < tokenCount {
+			next = fmt.Sprintf(`"/api/v1/accounts/0.0.1800/tokens?limit=%d&token.id=gt:0.0.%d"`, pageSize, last)
+		}
+		_, _ = fmt.Fprintf(w, `{"tokens":[%s],"links":{"next":%s}}`, tokens, next)
+	}))
+	defer server.Close()
+
+	accountID := AccountID{Account: 1800}
+	relationships := make([]TokenRelationship, 0)
+	pages := 0
+	next := ""
+	for {
+		page, err := accountID._GetTokenRelationships(server.URL, pageSize, next)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page.Relationships), pageSize)
+		relationships = append(relationships, page.Relationships...)
+		pages++
+		if page.Next == "" {
+			break
+		}
+		next = page.Next
+	}
+
+	require.Equal(t, 3, pages)
+	require.Len(t, relationships, tokenCount)
+	for i, relationship := range relationships {
+		require.Equal(t, TokenID{Token: uint64(i + 1)}, relationship.TokenID)
+		require.Equal(t, uint64((i+1)*100), relationship.Balance)
+		require.Equal(t, uint32(2), relationship.Decimals)
+		require.False(t, *relationship.FreezeStatus)
+		require.Nil(t, relationship.KycStatus)
+		require.True(t, relationship.AutomaticAssociation)
+	}
+
+	_, err := accountID._GetTokenRelationships(server.URL, pageSize, "https://example.com/tokens")
+	require.Error(t, err)
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+	_, err = accountID.GetTokenRelationships(client, pageSize, "")
+	require.ErrorContains(t, err, "mirror node is not set")
+}
@@ -49,7 +49,10 @@ type AccountInfo struct {
 	OwnedNfts                     int64
 	MaxAutomaticTokenAssociations uint32
 	AliasKey                      *PublicKey
-	LedgerID                      LedgerID
+	// Alias is the raw alias of the account: a serialized public key, or the 20-byte EVM address of an account
+	// lazily created by a transfer to that address.
+	Alias    []byte
+	LedgerID LedgerID
 	// Deprecated
 	HbarAllowances []HbarAllowance
 	// Deprecated
@@ -62,7 +65,7 @@ type AccountInfo struct {
 
 func _AccountInfoFromProtobuf(pb *services.CryptoGetInfoResponse_AccountInfo) (AccountInfo, error) {
 	if pb == nil {
-		return AccountInfo{}, errParameterNull
+		return AccountInfo{}, ErrParameterNull
 	}
 
 	pubKey, err := _KeyFromProtobuf(pb.Key)
@@ -119,6 +122,7 @@ func _AccountInfoFromProtobuf(pb *services.CryptoGetInfoResponse_AccountInfo) (A
 		OwnedNfts:                      pb.OwnedNfts,
 		MaxAutomaticTokenAssociations:  uint32(pb.MaxAutomaticTokenAssociations),
 		AliasKey:                       alias,
+		Alias:                          pb.Alias,
 		LedgerID:                       LedgerID{pb.LedgerId},
 		EthereumNonce:                  pb.EthereumNonce,
 		StakingInfo:                    &stakingInfo,
@@ -133,8 +137,8 @@ func (info AccountInfo) _ToProtobuf() *services.CryptoGetInfoResponse_AccountInf
 		liveHashes[i] = singleRelationship
 	}
 
-	var alias []byte
-	if info.AliasKey != nil {
+	alias := info.Alias
+	if len(alias) == 0 && info.AliasKey != nil {
 		alias, _ = protobuf.Marshal(info.AliasKey._ToProtoKey())
 	}
 
@@ -179,7 +183,7 @@ func (info AccountInfo) ToBytes() []byte {
 // AccountInfoFromBytes returns an AccountInfo from byte array
 func AccountInfoFromBytes(data []byte) (AccountInfo, error) {
 	if data == nil {
-		return AccountInfo{}, errByteArrayNull
+		return AccountInfo{}, ErrByteArrayNull
 	}
 	pb := services.CryptoGetInfoResponse_AccountInfo{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -98,6 +98,19 @@ func (q *AccountInfoQuery) SetMaxQueryPayment(queryMaxPayment Hbar) *AccountInfo
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *AccountInfoQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *AccountInfoQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *AccountInfoQuery) SetRequestStateProof(request bool) *AccountInfoQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetMaxRetry sets the max number of errors before execution will fail.
 func (q *AccountInfoQuery) SetMaxRetry(count int) *AccountInfoQuery {
 	q.Query.SetMaxRetry(count)
@@ -110,6 +123,12 @@ func (q *AccountInfoQuery) SetMaxBackoff(max time.Duration) *AccountInfoQuery {
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *AccountInfoQuery) SetSingleNode(singleNode bool) *AccountInfoQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *AccountInfoQuery) SetMinBackoff(min time.Duration) *AccountInfoQuery {
 	q.Query.SetMinBackoff(min)
@@ -160,7 +179,7 @@ func (q *AccountInfoQuery) validateNetworkOnIDs(client *Client) error {
 		return nil
 	}
 
-	if q.accountID != nil {
+	if q.accountID != nil && q.accountID.AliasKey == nil && q.accountID.AliasEvmAddress == nil {
 		if err := q.accountID.ValidateChecksum(client); err != nil {
 			return err
 		}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 func TestUnitAccountInfoQueryValidate(t *testing.T) {
@@ -165,3 +166,146 @@ func TestUnitAccountInfoQueryMock(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, HbarFromTinybar(2), cost)
 }
+
+func TestUnitAccountInfoQueryPaymentTransactionMaxFee(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyFromStringEd25519(mockPrivateKey)
+	require.NoError(t, err)
+
+	var paymentFees []uint64
+	call := func(request *services.Query) *services.Response {
+		body := services.TransactionBody{}
+		require.NoError(t, protobuf.Unmarshal(request.GetCryptoGetInfo().GetHeader().GetPayment().GetBodyBytes(), &body))
+		paymentFees = append(paymentFees, body.GetTransactionFee())
+
+		return &services.Response{
+			Response: &services.Response_CryptoGetInfo{
+				CryptoGetInfo: &services.CryptoGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountInfo: &services.CryptoGetInfoResponse_AccountInfo{
+						AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1234}},
+						Key:       key.PublicKey()._ToProtoKey(),
+					},
+				},
+			},
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call, call}})
+	defer server.Close()
+
+	query := NewAccountInfoQuery().
+		SetAccountID(AccountID{Account: 1234}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetQueryPayment(HbarFromTinybar(2))
+	require.Equal(t, NewHbar(1), query.GetPaymentTransactionMaxFee())
+
+	_, err = query.Execute(client)
+	require.NoError(t, err)
+
+	_, err = query.SetPaymentTransactionMaxFee(NewHbar(5)).Execute(client)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{uint64(NewHbar(1).AsTinybar()), uint64(NewHbar(5).AsTinybar())}, paymentFees)
+}
+
+func TestUnitAccountInfoQueryZeroCostSkipsPayment(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyFromStringEd25519(mockPrivateKey)
+	require.NoError(t, err)
+
+	var payments []*services.Transaction
+	call := func(request *services.Query) *services.Response {
+		header := request.GetCryptoGetInfo().GetHeader()
+		if header.GetResponseType() == services.ResponseType_COST_ANSWER {
+			return &services.Response{
+				Response: &services.Response_CryptoGetInfo{
+					CryptoGetInfo: &services.CryptoGetInfoResponse{
+						Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER, Cost: 0},
+					},
+				},
+			}
+		}
+
+		payments = append(payments, header.GetPayment())
+		return &services.Response{
+			Response: &services.Response_CryptoGetInfo{
+				CryptoGetInfo: &services.CryptoGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountInfo: &services.CryptoGetInfoResponse_AccountInfo{
+						AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1234}},
+						Key:       key.PublicKey()._ToProtoKey(),
+					},
+				},
+			},
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call, call}})
+	defer server.Close()
+
+	signatures := 0
+	client.SetOperatorWith(AccountID{Account: 1800}, key.PublicKey(), func(message []byte) []byte {
+		signatures++
+		return key.Sign(message)
+	})
+
+	info, err := NewAccountInfoQuery().
+		SetAccountID(AccountID{Account: 1234}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 1234}, info.AccountID)
+
+	require.Equal(t, []*services.Transaction{nil}, payments)
+	// Only the cost query was paid for.
+	require.Equal(t, 1, signatures)
+}
+
+func TestUnitAccountInfoQueryByAlias(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyFromStringEd25519(mockPrivateKey)
+	require.NoError(t, err)
+
+	aliasID, err := AccountIDFromEvmAddress(0, 0, "302a300506032b6570032100114e6abc371b82da")
+	require.NoError(t, err)
+	alias := *aliasID.AliasEvmAddress
+
+	call := func(request *services.Query) *services.Response {
+		require.Equal(t, alias, request.GetCryptoGetInfo().GetAccountID().GetAlias())
+
+		return &services.Response{
+			Response: &services.Response_CryptoGetInfo{
+				CryptoGetInfo: &services.CryptoGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountInfo: &services.CryptoGetInfoResponse_AccountInfo{
+						AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1234}},
+						Key:       key.PublicKey()._ToProtoKey(),
+						Alias:     alias,
+					},
+				},
+			},
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call}})
+	defer server.Close()
+	client.SetAutoValidateChecksums(true)
+
+	info, err := NewAccountInfoQuery().
+		SetAccountID(aliasID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetQueryPayment(HbarFromTinybar(2)).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 1234}, info.AccountID)
+	require.Equal(t, alias, info.Alias)
+	require.Nil(t, info.AliasKey)
+
+	roundTrip, err := AccountInfoFromBytes(info.ToBytes())
+	require.NoError(t, err)
+	require.Equal(t, alias, roundTrip.Alias)
+}
@@ -93,6 +93,19 @@ func (q *AccountRecordsQuery) SetMaxQueryPayment(maxPayment Hbar) *AccountRecord
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *AccountRecordsQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *AccountRecordsQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *AccountRecordsQuery) SetRequestStateProof(request bool) *AccountRecordsQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *AccountRecordsQuery) SetQueryPayment(paymentAmount Hbar) *AccountRecordsQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -118,6 +131,12 @@ func (q *AccountRecordsQuery) SetMaxBackoff(max time.Duration) *AccountRecordsQu
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *AccountRecordsQuery) SetSingleNode(singleNode bool) *AccountRecordsQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 func (q *AccountRecordsQuery) SetMinBackoff(min time.Duration) *AccountRecordsQuery {
 	q.Query.SetMinBackoff(min)
 	return q
@@ -72,30 +72,31 @@ func (q *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
-	resp, err := q.Query.execute(client, q)
-
+	stakers, err := q.ExecuteProxyStakers(client)
 	if err != nil {
 		return []Transfer{}, err
 	}
 
-	var stakers = make([]Transfer, len(resp.GetCryptoGetProxyStakers().Stakers.ProxyStaker))
-
-	// TODO: This is wrong, q _Method shold return `[]ProxyStaker` not `[]Transfer`
-	for i, element := range resp.GetCryptoGetProxyStakers().Stakers.ProxyStaker {
-		id := _AccountIDFromProtobuf(element.AccountID)
-		accountID := AccountID{}
-
-		if id == nil {
-			accountID = *id
+	transfers := make([]Transfer, len(stakers))
+	for i, staker := range stakers {
+		transfers[i] = Transfer{
+			AccountID: staker.AccountID,
+			Amount:    staker.Amount,
 		}
+	}
 
-		stakers[i] = Transfer{
-			AccountID: accountID,
-			Amount:    HbarFromTinybar(element.Amount),
-		}
+	return transfers, nil
+}
+
+// ExecuteProxyStakers executes the Query with the provided client and returns the accounts proxy staking to the
+// account with the amount each of them stakes. The network does not report pending rewards for proxy stakers.
+func (q *AccountStakersQuery) ExecuteProxyStakers(client *Client) ([]ProxyStaker, error) {
+	resp, err := q.Query.execute(client, q)
+	if err != nil {
+		return []ProxyStaker{}, err
 	}
 
-	return stakers, err
+	return _ProxyStakersFromProtobuf(resp.GetCryptoGetProxyStakers().GetStakers()), nil
 }
 
 // SetMaxQueryPayment sets the maximum payment allowed for this Query.
@@ -104,6 +105,19 @@ func (q *AccountStakersQuery) SetMaxQueryPayment(maxPayment Hbar) *AccountStaker
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *AccountStakersQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *AccountStakersQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *AccountStakersQuery) SetRequestStateProof(request bool) *AccountStakersQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *AccountStakersQuery) SetQueryPayment(paymentAmount Hbar) *AccountStakersQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -129,6 +143,12 @@ func (q *AccountStakersQuery) SetMaxBackoff(max time.Duration) *AccountStakersQu
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *AccountStakersQuery) SetSingleNode(singleNode bool) *AccountStakersQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *AccountStakersQuery) SetMinBackoff(min time.Duration) *AccountStakersQuery {
 	q.Query.SetMinBackoff(min)
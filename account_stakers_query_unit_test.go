@@ -185,3 +185,55 @@ func TestUnitAccountStakersQueryQueryMock(t *testing.T) {
 	_, err := query.Execute(client)
 	require.NoError(t, err)
 }
+
+func TestUnitAccountStakersQueryProxyStakers(t *testing.T) {
+	t.Parallel()
+
+	stakers := &services.AllProxyStakers{
+		AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+		ProxyStaker: []*services.ProxyStaker{
+			{AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1801}}, Amount: 500},
+			{AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1802}}, Amount: 250},
+		},
+	}
+	expected := []ProxyStaker{
+		{AccountID: AccountID{Account: 1801}, Amount: HbarFromTinybar(500)},
+		{AccountID: AccountID{Account: 1802}, Amount: HbarFromTinybar(250)},
+	}
+	require.Equal(t, expected, _ProxyStakersFromProtobuf(stakers))
+
+	response := func(responseType services.ResponseType) *services.Response {
+		return &services.Response{
+			Response: &services.Response_CryptoGetProxyStakers{
+				CryptoGetProxyStakers: &services.CryptoGetStakersResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: responseType, Cost: 0},
+					Stakers: stakers,
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{
+		response(services.ResponseType_COST_ANSWER),
+		response(services.ResponseType_ANSWER_ONLY),
+		response(services.ResponseType_COST_ANSWER),
+		response(services.ResponseType_ANSWER_ONLY),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	query := NewAccountStakersQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800})
+
+	result, err := query.ExecuteProxyStakers(client)
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	transfers, err := query.Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, []Transfer{
+		{AccountID: AccountID{Account: 1801}, Amount: HbarFromTinybar(500)},
+		{AccountID: AccountID{Account: 1802}, Amount: HbarFromTinybar(250)},
+	}, transfers)
+}
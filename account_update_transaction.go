@@ -110,6 +110,18 @@ func (tx *AccountUpdateTransaction) SetKey(key Key) *AccountUpdateTransaction {
 	return tx
 }
 
+// SetKeyFromProtobuf sets the key like SetKey, from a protobuf Key received from elsewhere. If the key is not well
+// formed, see KeyFromProtobuf, the key is left unchanged and the reason is returned.
+func (tx *AccountUpdateTransaction) SetKeyFromProtobuf(pbKey *services.Key) (*AccountUpdateTransaction, error) {
+	tx._RequireNotFrozen()
+	key, err := KeyFromProtobuf(pbKey)
+	if err != nil {
+		return tx, err
+	}
+	tx.key = key
+	return tx, nil
+}
+
 func (tx *AccountUpdateTransaction) GetKey() (Key, error) {
 	return tx.key, nil
 }
@@ -312,6 +324,19 @@ func (tx *AccountUpdateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *AccountUpdateTransaction) SignWithSigner(signer Signer) *AccountUpdateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this AccountUpdateTransaction.
+func (tx *AccountUpdateTransaction) SetSigningKeys(keys []PublicKey) *AccountUpdateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *AccountUpdateTransaction) AddSignature(publicKey PublicKey, signature []byte) *AccountUpdateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -398,6 +423,12 @@ func (tx *AccountUpdateTransaction) SetMaxBackoff(max time.Duration) *AccountUpd
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *AccountUpdateTransaction) SetSingleNode(singleNode bool) *AccountUpdateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *AccountUpdateTransaction) SetMinBackoff(min time.Duration) *AccountUpdateTransaction {
 	tx.Transaction.SetMinBackoff(min)
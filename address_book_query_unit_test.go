@@ -6,7 +6,9 @@ package hedera
 import (
 	"testing"
 
+	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 /*-
@@ -52,3 +54,48 @@ func TestUnitAddressBookQueryCoverage(t *testing.T) {
 	query.GetLimit()
 	query.GetFileID()
 }
+
+func TestUnitNodeAddressBookGetNodeInfos(t *testing.T) {
+	t.Parallel()
+
+	recorded, err := protobuf.Marshal(&services.NodeAddressBook{
+		NodeAddress: []*services.NodeAddress{
+			{
+				NodeId:        0,
+				NodeAccountId: AccountID{Account: 3}._ToProtobuf(),
+				NodeCertHash:  []byte("0123abcd"),
+				Description:   "node 0",
+				ServiceEndpoint: []*services.ServiceEndpoint{
+					{IpAddressV4: []byte{34, 94, 106, 61}, Port: 50211},
+					{IpAddressV4: []byte{34, 94, 106, 61}, Port: 50212},
+				},
+			},
+			{
+				NodeId:        1,
+				NodeAccountId: AccountID{Account: 4}._ToProtobuf(),
+				ServiceEndpoint: []*services.ServiceEndpoint{
+					{IpAddressV4: []byte{35, 237, 119, 55}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	book, err := NodeAddressBookFromBytes(recorded)
+	require.NoError(t, err)
+
+	require.Equal(t, []NodeInfo{
+		{
+			AccountID:   AccountID{Account: 3},
+			NodeID:      0,
+			Endpoints:   []string{"34.94.106.61:50211", "34.94.106.61:50212"},
+			CertHash:    []byte("0123abcd"),
+			Description: "node 0",
+		},
+		{
+			AccountID: AccountID{Account: 4},
+			NodeID:    1,
+			Endpoints: []string{"35.237.119.55:50211"},
+		},
+	}, book.GetNodeInfos())
+}
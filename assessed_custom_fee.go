@@ -90,7 +90,7 @@ func (fee *AssessedCustomFee) ToBytes() []byte {
 // AssessedCustomFeeFromBytes returns a AssessedCustomFee from bytes
 func AssessedCustomFeeFromBytes(data []byte) (AssessedCustomFee, error) {
 	if data == nil {
-		return AssessedCustomFee{}, errByteArrayNull
+		return AssessedCustomFee{}, ErrByteArrayNull
 	}
 	pb := services.AssessedCustomFee{}
 	err := protobuf.Unmarshal(data, &pb)
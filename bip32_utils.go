@@ -20,6 +20,11 @@ package hedera
  *
  */
 
+import (
+	"strconv"
+	"strings"
+)
+
 var hardenedBit uint32 = 0x80000000
 
 // Harden the index
@@ -31,3 +36,35 @@ func ToHardenedIndex(index uint32) uint32 {
 func IsHardenedIndex(index uint32) bool {
 	return (index & hardenedBit) != 0
 }
+
+// _ParseDerivationPath parses a BIP-32 path such as "m/44'/3030'/0'/0/0" into its indices, with the hardened
+// bit set on segments marked with ', h or H.
+func _ParseDerivationPath(path string) ([]uint32, error) {
+	segments := strings.Split(path, "/")
+	if segments[0] != "m" {
+		return nil, ErrInvalidDerivationPath{Path: path, Reason: "it must start with m"}
+	}
+
+	indices := make([]uint32, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		hardened := strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") || strings.HasSuffix(segment, "H")
+		if hardened {
+			segment = segment[:len(segment)-1]
+		}
+
+		index, err := strconv.ParseUint(segment, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidDerivationPath{Path: path, Reason: "segment `" + segment + "` is not an index"}
+		}
+		if index >= uint64(hardenedBit) {
+			return nil, ErrInvalidDerivationPath{Path: path, Reason: "index " + segment + " is out of range"}
+		}
+
+		if hardened {
+			index = uint64(ToHardenedIndex(uint32(index)))
+		}
+		indices = append(indices, uint32(index))
+	}
+
+	return indices, nil
+}
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -46,8 +47,13 @@ var testnetNodes, _ = NodeAddressBookFromBytes(testnetAddress)
 // Client is the Hedera protocol wrapper for the SDK used by all
 // transaction and query types.
 type Client struct {
-	defaultMaxTransactionFee Hbar
-	defaultMaxQueryPayment   Hbar
+	defaultMaxTransactionFee        Hbar
+	networkMaxTransactionFees       map[NetworkName]Hbar
+	feeCache                        *_FeeCache
+	defaultMaxQueryPayment          Hbar
+	defaultTransactionValidDuration time.Duration
+	maxTransactionMemoBytes         int
+	validStartSkew                  *time.Duration
 
 	operator *_Operator
 
@@ -55,6 +61,13 @@ type Client struct {
 	mirrorNetwork                   *_MirrorNetwork
 	autoValidateChecksums           bool
 	defaultRegenerateTransactionIDs bool
+	disableAutoSignWithOperator     bool
+	useHealthyNodesOnly             bool
+	duplicateTransactionIDCheck     DuplicateTransactionIDCheck
+	submittedTransactionIDs         *_SubmittedTransactionIDs
+	nodeSelectionPolicy             NodeSelectionPolicy
+	requestInterceptor              _RequestInterceptor
+	nodeUnhealthyClassifier         func(err error) bool
 	maxAttempts                     *int
 
 	maxBackoff time.Duration
@@ -70,11 +83,130 @@ type Client struct {
 // TransactionSigner is a closure or function that defines how transactions will be signed
 type TransactionSigner func(message []byte) []byte
 
+// TransactionSignerWithContext is a TransactionSigner which can be canceled through ctx and can fail, such as a
+// signer calling a remote key service.
+type TransactionSignerWithContext func(ctx context.Context, message []byte) ([]byte, error)
+
+// Signer signs messages on behalf of a key the SDK never holds, such as a key kept in a cloud KMS.
+type Signer interface {
+	// PublicKey returns the public key of the key used to sign.
+	PublicKey() PublicKey
+	// Sign returns the signature of message, or an error if it could not be signed.
+	Sign(message []byte) ([]byte, error)
+}
+
+// ConcurrentSigner is a Signer which opts in to signing a transaction's node bodies concurrently, such as a client
+// for an HSM or a remote key service where every call has latency. Its Sign method must be safe to call from
+// several goroutines at once. All other signers are called for one body after the other.
+type ConcurrentSigner interface {
+	Signer
+	// SigningConcurrency returns how many bodies may be signed at once.
+	SigningConcurrency() int
+}
+
 type _Operator struct {
 	accountID  AccountID
 	privateKey *PrivateKey
 	publicKey  PublicKey
-	signer     TransactionSigner
+	signer     *_TransactionSigner
+}
+
+// defaultNetworkMaxTransactionFees are the minimum default max transaction fees on each network, reflecting
+// that fees on mainnet are realistically higher than on the test networks.
+var defaultNetworkMaxTransactionFees = map[NetworkName]Hbar{
+	NetworkNameMainnet:    NewHbar(2),
+	NetworkNameTestnet:    NewHbar(1),
+	NetworkNamePreviewnet: NewHbar(1),
+}
+
+// feeCacheLifetime bounds how long a fee learned from a node is used, since exchange rates and fee schedules change.
+const feeCacheLifetime = time.Hour
+
+// _FeeCache holds the fees learned by a client per transaction type.
+type _FeeCache struct {
+	lock sync.Mutex
+	fees map[string]_CachedFee
+}
+
+type _CachedFee struct {
+	fee       Hbar
+	learnedAt time.Time
+}
+
+// _NewFeeCache returns an empty fee cache.
+func _NewFeeCache() *_FeeCache {
+	return &_FeeCache{fees: make(map[string]_CachedFee)}
+}
+
+// DuplicateTransactionIDCheck selects what Execute does when a transaction ID the client already submitted is
+// about to be submitted again while it is still valid, which the network would reject with DUPLICATE_TRANSACTION.
+type DuplicateTransactionIDCheck int
+
+const (
+	// DuplicateTransactionIDCheckOff does not track submitted transaction IDs.
+	DuplicateTransactionIDCheckOff DuplicateTransactionIDCheck = iota
+	// DuplicateTransactionIDCheckWarn logs a warning and submits the transaction anyway.
+	DuplicateTransactionIDCheckWarn
+	// DuplicateTransactionIDCheckError fails with ErrDuplicateTransactionID without submitting the transaction.
+	DuplicateTransactionIDCheckError
+)
+
+// NodeSelectionPolicy selects the order in which nodes are picked for transactions and queries which have not
+// set their node account IDs.
+type NodeSelectionPolicy int
+
+const (
+	// NodeSelectionPolicyRandom picks nodes in a random order.
+	NodeSelectionPolicyRandom NodeSelectionPolicy = iota
+	// NodeSelectionPolicyRoundRobin cycles through the nodes in account ID order, starting one node further on
+	// each time.
+	NodeSelectionPolicyRoundRobin
+	// NodeSelectionPolicyLeastRecentlyUsed picks the nodes which have gone unused the longest first.
+	NodeSelectionPolicyLeastRecentlyUsed
+)
+
+// maxSubmittedTransactionIDs bounds how many submitted transaction IDs a client remembers; the oldest are
+// forgotten first.
+const maxSubmittedTransactionIDs = 10000
+
+// _SubmittedTransactionIDs holds the transaction IDs submitted by a client until they expire.
+type _SubmittedTransactionIDs struct {
+	lock    sync.Mutex
+	expiry  map[string]time.Time
+	ordered []string
+}
+
+// _NewSubmittedTransactionIDs returns an empty set of submitted transaction IDs.
+func _NewSubmittedTransactionIDs() *_SubmittedTransactionIDs {
+	return &_SubmittedTransactionIDs{expiry: make(map[string]time.Time)}
+}
+
+// _Submit records transactionID as submitted until expiry and reports whether it was already submitted and
+// has not expired yet.
+func (ids *_SubmittedTransactionIDs) _Submit(transactionID string, expiry time.Time) bool {
+	ids.lock.Lock()
+	defer ids.lock.Unlock()
+
+	now := time.Now()
+	for len(ids.ordered) > 0 {
+		oldest := ids.ordered[0]
+		if len(ids.ordered) < maxSubmittedTransactionIDs && ids.expiry[oldest].After(now) {
+			break
+		}
+		delete(ids.expiry, oldest)
+		ids.ordered = ids.ordered[1:]
+	}
+
+	if existing, ok := ids.expiry[transactionID]; ok && existing.After(now) {
+		return true
+	}
+
+	if _, ok := ids.expiry[transactionID]; !ok {
+		ids.ordered = append(ids.ordered, transactionID)
+	}
+	ids.expiry[transactionID] = expiry
+
+	return false
 }
 
 var mainnetMirror = []string{"mainnet-public.mirrornode.hedera.com:443"}
@@ -130,13 +262,14 @@ func _NewClient(network _Network, mirrorNetwork []string, ledgerId *LedgerID) *C
 		minBackoff:                      250 * time.Millisecond,
 		maxBackoff:                      8 * time.Second,
 		defaultRegenerateTransactionIDs: true,
+		feeCache:                        _NewFeeCache(),
 		defaultNetworkUpdatePeriod:      24 * time.Hour,
 		networkUpdateContext:            ctx,
 		cancelNetworkUpdate:             cancel,
 		logger:                          defaultLogger,
 	}
 
-	client.SetMirrorNetwork(mirrorNetwork)
+	_ = client.SetMirrorNetwork(mirrorNetwork)
 	if ledgerId != nil {
 		client.SetLedgerID(*ledgerId)
 	}
@@ -204,7 +337,9 @@ func ClientForName(name string) (*Client, error) {
 		network["127.0.0.1:50213"] = AccountID{Account: 3}
 		mirror := []string{"127.0.0.1:433"}
 		client := ClientForNetwork(network)
-		client.SetMirrorNetwork(mirror)
+		if err := client.SetMirrorNetwork(mirror); err != nil {
+			return &Client{}, err
+		}
 		return client, nil
 	default:
 		return &Client{}, fmt.Errorf("%q is not recognized as a valid Hedera _Network", name)
@@ -213,7 +348,7 @@ func ClientForName(name string) (*Client, error) {
 
 type _ConfigOperator struct {
 	AccountID  string `json:"accountId"`
-	PrivateKey string `json:"privateKey"`
+	PrivateKey string `json:"privateKey,omitempty"`
 }
 
 // TODO: Implement complete spec: https://gitlab.com/launchbadge/hedera/sdk/python/-/issues/45
@@ -319,7 +454,7 @@ func ClientFromConfig(jsonBytes []byte) (*Client, error) {
 		accountID:  operatorID,
 		privateKey: &operatorKey,
 		publicKey:  operatorKey.PublicKey(),
-		signer:     operatorKey.Sign,
+		signer:     _TransactionSignerForInfallible(operatorKey.PublicKey(), operatorKey.Sign),
 	}
 
 	client.operator = &operator
@@ -327,6 +462,33 @@ func ClientFromConfig(jsonBytes []byte) (*Client, error) {
 	return client, nil
 }
 
+// ToConfig serializes the client's current network, mirror network and operator to the JSON accepted by
+// ClientFromConfig, e.g. to persist a network which was updated from an address book.
+// The operator's private key is only included when includePrivateKey is true and the operator was set with
+// a private key rather than a signer; a config without it must have it filled in before it can be loaded.
+func (client *Client) ToConfig(includePrivateKey bool) ([]byte, error) {
+	network := make(map[string]string)
+	for address, accountID := range client.GetNetwork() {
+		network[address] = accountID.String()
+	}
+
+	config := _ClientConfig{
+		Network:       network,
+		MirrorNetwork: client.GetMirrorNetwork(),
+	}
+
+	if client.operator != nil {
+		config.Operator = &_ConfigOperator{
+			AccountID: client.operator.accountID.String(),
+		}
+		if includePrivateKey && client.operator.privateKey != nil {
+			config.Operator.PrivateKey = client.operator.privateKey.String()
+		}
+	}
+
+	return json.Marshal(config)
+}
+
 // ClientFromConfigFile takes a filename string representing the path to a JSON encoded
 // Client file and returns a Client based on the configuration.
 func ClientFromConfigFile(filename string) (*Client, error) {
@@ -372,6 +534,17 @@ func (client *Client) GetNetwork() map[string]AccountID {
 	return client.network._GetNetwork()
 }
 
+// GetNodeAddress returns the "host:port" address the client dials for the node with the given account ID.
+// It returns an error if the node is not part of the client's network.
+func (client *Client) GetNodeAddress(nodeAccountID AccountID) (string, error) {
+	node, ok := client.network._GetNodeForAccountID(nodeAccountID)
+	if !ok {
+		return "", ErrInvalidNodeAccountIDSet{nodeAccountID}
+	}
+
+	return node.address._String(), nil
+}
+
 // SetMaxNodeReadmitTime The maximum amount of time to wait before attempting to
 // reconnect to a node that has been removed from the network.
 func (client *Client) SetMaxNodeReadmitTime(readmitTime time.Duration) {
@@ -397,7 +570,9 @@ func (client *Client) GetMinNodeReadmitPeriod() time.Duration {
 }
 
 // SetMaxBackoff The maximum amount of time to wait between retries.
-// Every retry attempt will increase the wait time exponentially until it reaches this time.
+// Every retry attempt will increase the wait time exponentially until it reaches this time. Each wait is
+// randomized by up to half either way but never exceeds this time. Defaults to 8 seconds; a request's own
+// SetMaxBackoff takes precedence.
 func (client *Client) SetMaxBackoff(max time.Duration) {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -412,7 +587,8 @@ func (client *Client) GetMaxBackoff() time.Duration {
 	return client.maxBackoff
 }
 
-// SetMinBackoff sets the minimum amount of time to wait between retries.
+// SetMinBackoff sets the minimum amount of time to wait between retries, which is also the wait before the first
+// retry. Defaults to 250 milliseconds; a request's own SetMinBackoff takes precedence.
 func (client *Client) SetMinBackoff(min time.Duration) {
 	if min.Nanoseconds() < 0 {
 		panic("minBackoff must be a positive duration")
@@ -427,8 +603,12 @@ func (client *Client) GetMinBackoff() time.Duration {
 	return client.minBackoff
 }
 
-// SetMaxAttempts sets the maximum number of times to attempt a transaction or query.
+// SetMaxAttempts sets the maximum number of times to attempt a transaction or query, overriding the request's
+// own SetMaxRetry, which defaults to 10.
 func (client *Client) SetMaxAttempts(max int) {
+	if max < 1 {
+		panic("maxAttempts must be at least 1")
+	}
 	client.maxAttempts = &max
 }
 
@@ -486,10 +666,11 @@ func (client *Client) SetMaxNodesPerTransaction(max int) {
 	client.network._SetMaxNodesPerTransaction(max)
 }
 
-// SetNetwork replaces all _Nodes in the Client with a new set of _Nodes.
-// (e.g. for an Address Book update).
-func (client *Client) SetMirrorNetwork(mirrorNetwork []string) {
-	_ = client.mirrorNetwork._SetNetwork(mirrorNetwork)
+// SetMirrorNetwork replaces the mirror nodes used by mirror node queries and subscriptions, such as
+// TopicMessageQuery, for example to use a self-hosted mirror node. Each endpoint must be a `host:port` string;
+// if any is not, the mirror network is left unchanged and an error is returned.
+func (client *Client) SetMirrorNetwork(mirrorNetwork []string) error {
+	return client.mirrorNetwork._SetNetwork(mirrorNetwork)
 }
 
 // GetNetwork returns the mirror network node list.
@@ -563,6 +744,108 @@ func (client *Client) GetDefaultRegenerateTransactionIDs() bool {
 	return client.defaultRegenerateTransactionIDs
 }
 
+// SetUseHealthyNodesOnly sets if freezing a transaction without node account IDs picks only nodes which are
+// not currently backed off. Otherwise backed off nodes are still picked after the healthy ones when there are
+// too few healthy nodes for a transaction. If no node is healthy, all nodes are considered.
+func (client *Client) SetUseHealthyNodesOnly(healthyOnly bool) *Client {
+	client.useHealthyNodesOnly = healthyOnly
+	return client
+}
+
+// GetUseHealthyNodesOnly returns if freezing a transaction picks only nodes which are not currently backed off.
+func (client *Client) GetUseHealthyNodesOnly() bool {
+	return client.useHealthyNodesOnly
+}
+
+// SetDuplicateTransactionIDCheck sets whether Execute remembers the transaction IDs this client submits and
+// warns or fails when one is submitted again before its valid duration has passed. Retries within a single
+// Execute are not duplicates. At most 10000 IDs are remembered.
+func (client *Client) SetDuplicateTransactionIDCheck(check DuplicateTransactionIDCheck) *Client {
+	client.duplicateTransactionIDCheck = check
+	if check != DuplicateTransactionIDCheckOff && client.submittedTransactionIDs == nil {
+		client.submittedTransactionIDs = _NewSubmittedTransactionIDs()
+	}
+
+	return client
+}
+
+// GetDuplicateTransactionIDCheck returns what Execute does when a transaction ID is submitted twice.
+func (client *Client) GetDuplicateTransactionIDCheck() DuplicateTransactionIDCheck {
+	return client.duplicateTransactionIDCheck
+}
+
+// SetNodeUnhealthyClassifier sets which errors from a gRPC call mark the node unhealthy. When classifier returns
+// true, the node is backed off and the request is retried on another node; otherwise the request fails with an
+// ErrHederaNetwork. Pass nil to restore DefaultNodeUnhealthyClassifier, which classifier can also call to only
+// adjust the default.
+func (client *Client) SetNodeUnhealthyClassifier(classifier func(err error) bool) *Client {
+	client.nodeUnhealthyClassifier = classifier
+	return client
+}
+
+// SetNodeSelectionPolicy sets the order in which nodes are picked for transactions and queries which have not
+// called SetNodeAccountIDs. Defaults to NodeSelectionPolicyRandom. Unhealthy nodes are still skipped as usual.
+func (client *Client) SetNodeSelectionPolicy(policy NodeSelectionPolicy) *Client {
+	client.nodeSelectionPolicy = policy
+	return client
+}
+
+// GetNodeSelectionPolicy returns the order in which nodes are picked for transactions and queries.
+func (client *Client) GetNodeSelectionPolicy() NodeSelectionPolicy {
+	return client.nodeSelectionPolicy
+}
+
+// _CheckDuplicateTransactionID records transactionID as submitted and warns or returns ErrDuplicateTransactionID
+// if it was already submitted and is still valid.
+func (client *Client) _CheckDuplicateTransactionID(transactionID TransactionID, validDuration time.Duration, logger Logger) error {
+	if client.duplicateTransactionIDCheck == DuplicateTransactionIDCheckOff || client.submittedTransactionIDs == nil {
+		return nil
+	}
+
+	validStart := time.Now()
+	if transactionID.ValidStart != nil {
+		validStart = *transactionID.ValidStart
+	}
+
+	if !client.submittedTransactionIDs._Submit(transactionID.String(), validStart.Add(validDuration)) {
+		return nil
+	}
+
+	if client.duplicateTransactionIDCheck == DuplicateTransactionIDCheckError {
+		return ErrDuplicateTransactionID{TransactionID: transactionID}
+	}
+
+	logger.Warn("transaction ID was already submitted by this client and is still valid", "transactionID", transactionID.String())
+	return nil
+}
+
+func (client *Client) _NodeAccountIDsForExecute() []AccountID {
+	return client.network._GetNodeAccountIDsForExecute(client.nodeSelectionPolicy, client.useHealthyNodesOnly)
+}
+
+// _NodeAccountIDForQuery picks the node a query without explicit node account IDs is sent to.
+func (client *Client) _NodeAccountIDForQuery() AccountID {
+	if client.nodeSelectionPolicy != NodeSelectionPolicyRandom {
+		if nodes := client.network._GetNodeAccountIDsForExecute(client.nodeSelectionPolicy, client.useHealthyNodesOnly); len(nodes) > 0 {
+			return nodes[0]
+		}
+	}
+
+	return client.network._GetNode().accountID
+}
+
+// SetAutoSignWithOperator sets if Execute signs transactions paid for by the operator with the operator's key.
+// When disabled, transactions are submitted with exactly the signatures already attached to them.
+func (client *Client) SetAutoSignWithOperator(autoSign bool) *Client {
+	client.disableAutoSignWithOperator = !autoSign
+	return client
+}
+
+// GetAutoSignWithOperator returns if Execute signs transactions paid for by the operator with the operator's key.
+func (client *Client) GetAutoSignWithOperator() bool {
+	return !client.disableAutoSignWithOperator
+}
+
 // SetNodeMinReadmitPeriod sets the minimum amount of time to wait before attempting to
 // reconnect to a node that has been removed from the network.
 func (client *Client) SetNodeMinReadmitPeriod(period time.Duration) {
@@ -595,7 +878,7 @@ func (client *Client) SetOperator(accountID AccountID, privateKey PrivateKey) *C
 		accountID:  accountID,
 		privateKey: &privateKey,
 		publicKey:  privateKey.PublicKey(),
-		signer:     privateKey.Sign,
+		signer:     _TransactionSignerForInfallible(privateKey.PublicKey(), privateKey.Sign),
 	}
 
 	return client
@@ -609,7 +892,21 @@ func (client *Client) SetOperatorWith(accountID AccountID, publicKey PublicKey,
 		accountID:  accountID,
 		privateKey: nil,
 		publicKey:  publicKey,
-		signer:     signer,
+		signer:     _TransactionSignerForInfallible(publicKey, signer),
+	}
+
+	return client
+}
+
+// SetOperatorWithSigner sets that account that will, by default, be paying for
+// transactions and queries built with the client and the Signer with which to sign them.
+// The client never holds the private key; every signature is requested from the Signer.
+func (client *Client) SetOperatorWithSigner(accountID AccountID, signer Signer) *Client {
+	client.operator = &_Operator{
+		accountID:  accountID,
+		privateKey: nil,
+		publicKey:  signer.PublicKey(),
+		signer:     _TransactionSignerFor(signer),
 	}
 
 	return client
@@ -643,22 +940,62 @@ func (client *Client) GetOperatorPublicKey() PublicKey {
 	return PublicKey{}
 }
 
-// Ping sends an AccountBalanceQuery to the specified _Node returning nil if no
-// problems occur. Otherwise, an error representing the status of the _Node will
-// be returned.
+// Ping sends an AccountBalanceQuery for the node's own account to only that _Node, returning nil if no
+// problems occur. Otherwise, an error representing the status of the _Node will be returned. The query is
+// free and is not retried, so a busy, unhealthy or unreachable _Node fails the ping rather than being skipped.
 func (client *Client) Ping(nodeID AccountID) error {
-	_, err := NewAccountBalanceQuery().
+	query := NewAccountBalanceQuery().
 		SetNodeAccountIDs([]AccountID{nodeID}).
-		SetAccountID(client.GetOperatorAccountID()).
-		Execute(client)
+		SetAccountID(nodeID)
+	// Client.SetMaxAttempts would override SetMaxRetry, so cap the attempts directly.
+	query.attemptLimit = 1
+
+	_, err := query.Execute(client)
 
 	return err
 }
 
-func (client *Client) PingAll() {
-	for _, s := range client.GetNetwork() {
-		_ = client.Ping(s)
+// PingAll pings every node in the network concurrently and returns the result of each Ping by node account ID.
+func (client *Client) PingAll() map[AccountID]error {
+	nodeIDs := make(map[AccountID]struct{})
+	for _, nodeID := range client.GetNetwork() {
+		nodeIDs[nodeID] = struct{}{}
+	}
+
+	results := make(map[AccountID]error, len(nodeIDs))
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for nodeID := range nodeIDs {
+		wg.Add(1)
+		go func(nodeID AccountID) {
+			defer wg.Done()
+			err := client.Ping(nodeID)
+			lock.Lock()
+			results[nodeID] = err
+			lock.Unlock()
+		}(nodeID)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// AccountExists reports whether accountID exists, using a free AccountBalanceQuery. It returns false when the
+// network answers INVALID_ACCOUNT_ID and an error for any other failure.
+func (client *Client) AccountExists(accountID AccountID) (bool, error) {
+	_, err := NewAccountBalanceQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	if err == nil {
+		return true, nil
+	}
+
+	var precheckErr ErrHederaPreCheckStatus
+	if errors.As(err, &precheckErr) && precheckErr.Status == StatusInvalidAccountID {
+		return false, nil
 	}
+
+	return false, err
 }
 
 // SetNetworkFromAddressBook replaces all nodes in this Client with the nodes in the Address Book.
@@ -697,6 +1034,152 @@ func (client *Client) GetDefaultMaxTransactionFee() Hbar {
 	return client.defaultMaxTransactionFee
 }
 
+// SetDefaultMaxTransactionFeeForNetwork sets the default max transaction fee used while the client is on the
+// given network. Transactions without their own max fee use the larger of it and their type's default, unless
+// SetDefaultMaxTransactionFee is set, which takes precedence on every network.
+func (client *Client) SetDefaultMaxTransactionFeeForNetwork(name NetworkName, fee Hbar) error {
+	if fee.AsTinybar() < 0 {
+		return errors.New("DefaultMaxTransactionFee must be non-negative")
+	}
+
+	if client.networkMaxTransactionFees == nil {
+		client.networkMaxTransactionFees = make(map[NetworkName]Hbar)
+	}
+
+	client.networkMaxTransactionFees[name] = fee
+	return nil
+}
+
+// GetDefaultMaxTransactionFeeForNetwork returns the default max transaction fee used while the client is on the
+// given network.
+func (client *Client) GetDefaultMaxTransactionFeeForNetwork(name NetworkName) Hbar {
+	if fee, ok := client.networkMaxTransactionFees[name]; ok {
+		return fee
+	}
+
+	return defaultNetworkMaxTransactionFees[name]
+}
+
+// _NetworkMaxTransactionFee returns the default max transaction fee for the network the client is on.
+func (client *Client) _NetworkMaxTransactionFee() Hbar {
+	ledgerID := client.GetLedgerID()
+	if ledgerID == nil {
+		return Hbar{}
+	}
+
+	name, _ := ledgerID.ToNetworkName()
+	return client.GetDefaultMaxTransactionFeeForNetwork(name)
+}
+
+// GetEstimatedMaxFee returns the minimum fee a node last required for transactions of the given type, such as
+// "TransferTransaction", and whether one is known. Fees are learned when a node rejects a transaction with
+// INSUFFICIENT_TX_FEE, and later transactions of the type without their own max fee are frozen with at least the
+// learned fee. Learned fees are forgotten after an hour or when InvalidateFeeCache is called.
+func (client *Client) GetEstimatedMaxFee(transactionType string) (Hbar, bool) {
+	if client.feeCache == nil {
+		return Hbar{}, false
+	}
+
+	client.feeCache.lock.Lock()
+	defer client.feeCache.lock.Unlock()
+
+	cached, ok := client.feeCache.fees[transactionType]
+	if !ok {
+		return Hbar{}, false
+	}
+
+	if time.Since(cached.learnedAt) > feeCacheLifetime {
+		delete(client.feeCache.fees, transactionType)
+		return Hbar{}, false
+	}
+
+	return cached.fee, true
+}
+
+// InvalidateFeeCache forgets every fee learned by the client. Call it when the network's fee schedule changes.
+func (client *Client) InvalidateFeeCache() {
+	if client.feeCache == nil {
+		return
+	}
+
+	client.feeCache.lock.Lock()
+	defer client.feeCache.lock.Unlock()
+
+	client.feeCache.fees = make(map[string]_CachedFee)
+}
+
+func (client *Client) _LearnFee(transactionType string, fee Hbar) {
+	if client.feeCache == nil {
+		return
+	}
+
+	client.feeCache.lock.Lock()
+	defer client.feeCache.lock.Unlock()
+
+	client.feeCache.fees[transactionType] = _CachedFee{fee: fee, learnedAt: time.Now()}
+}
+
+// SetDefaultTransactionValidDuration sets the valid duration used by transactions which don't set their own,
+// e.g. to give offline signing workflows more time. It is capped at the network maximum of 180 seconds.
+func (client *Client) SetDefaultTransactionValidDuration(duration time.Duration) *Client {
+	if duration > maxTransactionValidDuration {
+		duration = maxTransactionValidDuration
+	}
+
+	client.defaultTransactionValidDuration = duration
+	return client
+}
+
+// GetDefaultTransactionValidDuration returns the valid duration used by transactions which don't set their own,
+// or 0 if the SDK default of 120 seconds is used.
+func (client *Client) GetDefaultTransactionValidDuration() time.Duration {
+	return client.defaultTransactionValidDuration
+}
+
+// SetMaxTransactionMemoBytes sets the longest transaction memo, in bytes, which transactions accept when they are
+// frozen. It defaults to the network's current limit of 100 bytes; raise it if the network raises its limit.
+func (client *Client) SetMaxTransactionMemoBytes(maxBytes int) *Client {
+	client.maxTransactionMemoBytes = maxBytes
+	return client
+}
+
+// GetMaxTransactionMemoBytes returns the longest transaction memo, in bytes, which transactions accept.
+func (client *Client) GetMaxTransactionMemoBytes() int {
+	if client.maxTransactionMemoBytes <= 0 {
+		return defaultMaxTransactionMemoBytes
+	}
+
+	return client.maxTransactionMemoBytes
+}
+
+// SetValidStartSkew sets how far in the past the valid start of generated transaction IDs is placed, to absorb
+// clock skew between this machine and the network which would otherwise cause TRANSACTION_EXPIRED. Unless it is
+// set, transaction IDs are generated with TransactionIDGenerate, which backdates them by 8 to 13 seconds.
+// A negative skew is treated as 0.
+func (client *Client) SetValidStartSkew(skew time.Duration) *Client {
+	if skew < 0 {
+		skew = 0
+	}
+
+	client.validStartSkew = &skew
+	return client
+}
+
+// GetValidStartSkew returns how far in the past the valid start of generated transaction IDs is placed,
+// or nil if the backdating of TransactionIDGenerate is used.
+func (client *Client) GetValidStartSkew() *time.Duration {
+	return client.validStartSkew
+}
+
+// _GenerateTransactionID generates a transaction ID for accountID, backdated by the client's valid start skew.
+func (client *Client) _GenerateTransactionID(accountID AccountID) TransactionID {
+	if client.validStartSkew == nil {
+		return TransactionIDGenerate(accountID)
+	}
+
+	return NewTransactionIDWithValidStart(accountID, time.Now().UTC().Add(-*client.validStartSkew))
+}
+
 func (client *Client) SetLogger(logger Logger) *Client {
 	client.logger = logger
 	return client
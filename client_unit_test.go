@@ -25,10 +25,16 @@ package hedera
 
 import (
 	"bytes"
+	"encoding/json"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/stretchr/testify/assert"
 
@@ -328,3 +334,282 @@ func TestUnitClientLogger(t *testing.T) {
 	hl := client.GetLogger()
 	assert.Equal(t, hl, hederaLoger)
 }
+
+func TestUnitClientGetNodeAddress(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	err = client.SetNetwork(map[string]AccountID{
+		"0.testnet.hedera.com:50211": {Account: 3},
+		"1.testnet.hedera.com:50211": {Account: 4},
+	})
+	require.NoError(t, err)
+
+	address, err := client.GetNodeAddress(AccountID{Account: 3})
+	require.NoError(t, err)
+	require.Equal(t, "0.testnet.hedera.com:50211", address)
+
+	address, err = client.GetNodeAddress(AccountID{Account: 4})
+	require.NoError(t, err)
+	require.Equal(t, "1.testnet.hedera.com:50211", address)
+
+	_, err = client.GetNodeAddress(AccountID{Account: 5})
+	require.ErrorIs(t, err, ErrInvalidNodeAccountIDSet{AccountID{Account: 5}})
+}
+
+func TestUnitClientToConfig(t *testing.T) {
+	t.Parallel()
+
+	operatorKey := "302e020100300506032b657004220420db484b828e64b2d8f12ce3c0a0e93a0b8cce7af1bb8f39c97732394482538e10"
+	client, err := ClientFromConfig([]byte(`{
+		"network": {"127.0.0.1:50211": "0.0.3"},
+		"operator": {"accountId": "0.0.1800", "privateKey": "` + operatorKey + `"}
+	}`))
+	require.NoError(t, err)
+	defer client.Close()
+
+	err = client.SetNetwork(map[string]AccountID{
+		"127.0.0.1:50212": {Account: 4},
+		"127.0.0.1:50213": {Account: 5},
+	})
+	require.NoError(t, err)
+	client.SetMirrorNetwork([]string{"127.0.0.1:5600"})
+
+	config, err := client.ToConfig(true)
+	require.NoError(t, err)
+
+	var clientConfig _ClientConfig
+	require.NoError(t, json.Unmarshal(config, &clientConfig))
+	require.Equal(t, map[string]interface{}{
+		"127.0.0.1:50212": "0.0.4",
+		"127.0.0.1:50213": "0.0.5",
+	}, clientConfig.Network)
+	require.Equal(t, []interface{}{"127.0.0.1:5600"}, clientConfig.MirrorNetwork)
+	require.Equal(t, &_ConfigOperator{AccountID: "0.0.1800", PrivateKey: operatorKey}, clientConfig.Operator)
+
+	config, err = client.ToConfig(false)
+	require.NoError(t, err)
+	require.NotContains(t, string(config), "privateKey")
+	require.Contains(t, string(config), `"accountId":"0.0.1800"`)
+}
+
+func TestUnitClientToConfigRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// The mirror nodes are dialed when the config is loaded, so they have to be reachable.
+	client, server := NewMockClientAndServer([][]interface{}{{}, {}})
+	defer server.Close()
+
+	config, err := client.ToConfig(true)
+	require.NoError(t, err)
+
+	loaded, err := ClientFromConfig(config)
+	require.NoError(t, err)
+	defer loaded.Close()
+
+	require.Equal(t, client.GetNetwork(), loaded.GetNetwork())
+	require.ElementsMatch(t, client.GetMirrorNetwork(), loaded.GetMirrorNetwork())
+	require.Equal(t, client.GetOperatorAccountID(), loaded.GetOperatorAccountID())
+	require.Equal(t, client.GetOperatorPublicKey().String(), loaded.GetOperatorPublicKey().String())
+}
+
+func TestUnitClientSetMirrorNetworkValidatesEndpoints(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	require.NoError(t, client.SetMirrorNetwork([]string{"mirror.example.com:5600", "10.0.0.5:443"}))
+	require.ElementsMatch(t, []string{"mirror.example.com:5600", "10.0.0.5:443"}, client.GetMirrorNetwork())
+
+	for _, endpoint := range []string{"mirror.example.com", "mirror.example.com:port", "mirror.example.com:70000", ""} {
+		err := client.SetMirrorNetwork([]string{"mirror.example.com:5600", endpoint})
+		require.Error(t, err, endpoint)
+		require.Contains(t, err.Error(), "invalid mirror node endpoint")
+	}
+
+	require.ElementsMatch(t, []string{"mirror.example.com:5600", "10.0.0.5:443"}, client.GetMirrorNetwork())
+}
+
+func TestUnitClientPingQueriesEachNode(t *testing.T) {
+	t.Parallel()
+
+	var busyRequests int32
+	client := ClientForNetwork(map[string]AccountID{
+		"127.0.0.1:50211": {Account: 3},
+		"127.0.0.1:50212": {Account: 4},
+		"127.0.0.1:50213": {Account: 5},
+	}).SetRequestInterceptor(func(requestType string, request interface{}) (interface{}, error) {
+		assert.Equal(t, "AccountBalanceQuery", requestType)
+		accountID := _AccountIDFromProtobuf(request.(*services.Query).GetCryptogetAccountBalance().GetAccountID())
+		if accountID.Account == 4 {
+			return nil, status.Error(codes.Unavailable, "node down")
+		}
+		if accountID.Account == 5 {
+			atomic.AddInt32(&busyRequests, 1)
+			return &services.Response{
+				Response: &services.Response_CryptogetAccountBalance{
+					CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+						Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY, ResponseType: services.ResponseType_ANSWER_ONLY},
+					},
+				},
+			}, nil
+		}
+		return &services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountID: accountID._ToProtobuf(),
+					Balance:   1,
+				},
+			},
+		}, nil
+	})
+	client.SetMinBackoff(time.Millisecond)
+	client.SetMaxAttempts(5)
+
+	require.NoError(t, client.Ping(AccountID{Account: 3}))
+	require.Error(t, client.Ping(AccountID{Account: 4}))
+
+	// A busy node fails the ping after a single attempt, even though the client allows more.
+	require.ErrorIs(t, client.Ping(AccountID{Account: 5}), ErrHederaPreCheckStatus{Status: StatusBusy})
+	require.Equal(t, int32(1), atomic.LoadInt32(&busyRequests))
+
+	results := client.PingAll()
+	require.Len(t, results, 3)
+	assert.NoError(t, results[AccountID{Account: 3}])
+	assert.Error(t, results[AccountID{Account: 4}])
+	assert.Error(t, results[AccountID{Account: 5}])
+}
+
+func TestUnitClientBackoffAppliesToExecute(t *testing.T) {
+	t.Parallel()
+
+	operatorKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	busy := &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY}
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}}).
+		SetRequestInterceptor(NewCannedResponseInterceptor(map[string][]interface{}{
+			"TransferTransaction": {busy, busy, busy, &services.TransactionResponse{}},
+		}))
+	client.SetOperator(AccountID{Account: 2}, operatorKey)
+	client.SetMinBackoff(time.Millisecond)
+	client.SetMaxBackoff(5 * time.Millisecond)
+
+	start := time.Now()
+	_, err = NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 250*time.Millisecond)
+
+	min, max, err := NewAccountBalanceQuery().SetMinBackoff(2 * time.Millisecond).getBackoff(client)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Millisecond, min)
+	assert.Equal(t, 5*time.Millisecond, max)
+
+	// The request's min backoff takes precedence, but it can't exceed the client's max backoff.
+	_, err = NewTransferTransaction().
+		SetMinBackoff(10*time.Millisecond).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, NewHbar(1)).
+		Execute(client)
+	require.ErrorContains(t, err, "maxBackoff 5ms must be greater than or equal to minBackoff 10ms")
+
+	require.Panics(t, func() { client.SetMaxAttempts(0) })
+	require.Panics(t, func() { client.SetMinBackoff(10 * time.Millisecond) })
+}
+
+func TestUnitJitteredBackoffStaysWithinBounds(t *testing.T) {
+	t.Parallel()
+
+	backOff := backoff.NewExponentialBackOff()
+	backOff.InitialInterval = 100 * time.Millisecond
+	backOff.MaxInterval = time.Second
+	backOff.MaxElapsedTime = 0
+	backOff.Reset()
+
+	for i := 0; i < 20; i++ {
+		delay := _JitteredBackoff(backOff, 100*time.Millisecond, time.Second)
+		assert.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		assert.LessOrEqual(t, delay, time.Second)
+	}
+}
+
+func TestUnitClientNodeSelectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{
+		"127.0.0.1:50211": {Account: 5},
+		"127.0.0.1:50212": {Account: 3},
+		"127.0.0.1:50213": {Account: 4},
+	})
+	client.SetMaxNodesPerTransaction(1)
+	require.Equal(t, NodeSelectionPolicyRandom, client.GetNodeSelectionPolicy())
+
+	client.SetNodeSelectionPolicy(NodeSelectionPolicyRoundRobin)
+	picked := make([]AccountID, 0)
+	for i := 0; i < 4; i++ {
+		picked = append(picked, client._NodeAccountIDsForExecute()...)
+	}
+	assert.Equal(t, []AccountID{{Account: 3}, {Account: 4}, {Account: 5}, {Account: 3}}, picked)
+	assert.Equal(t, AccountID{Account: 4}, client._NodeAccountIDForQuery())
+
+	tx, err := NewTransferTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 2})).
+		FreezeWith(client)
+	require.NoError(t, err)
+	assert.Equal(t, []AccountID{{Account: 5}}, tx.GetNodeAccountIDs())
+
+	client.SetNodeSelectionPolicy(NodeSelectionPolicyLeastRecentlyUsed)
+	for _, id := range []AccountID{{Account: 3}, {Account: 5}} {
+		node, ok := client.network._GetNodeForAccountID(id)
+		require.True(t, ok)
+		node._InUse()
+	}
+	assert.Equal(t, []AccountID{{Account: 4}}, client._NodeAccountIDsForExecute())
+}
+
+func TestUnitClientNodeUnhealthyClassifier(t *testing.T) {
+	t.Parallel()
+
+	operatorKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	newClient := func() *Client {
+		client := ClientForNetwork(map[string]AccountID{
+			"127.0.0.1:50211": {Account: 3},
+			"127.0.0.1:50212": {Account: 4},
+		}).SetRequestInterceptor(NewCannedResponseInterceptor(map[string][]interface{}{
+			"TransferTransaction": {
+				status.Error(codes.PermissionDenied, "node is being upgraded"),
+				&services.TransactionResponse{},
+			},
+		}))
+		client.SetOperator(AccountID{Account: 2}, operatorKey)
+		return client
+	}
+	newTransfer := func() *TransferTransaction {
+		return NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+			AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 98}, NewHbar(1))
+	}
+
+	_, err = newTransfer().Execute(newClient())
+	var networkErr ErrHederaNetwork
+	require.ErrorAs(t, err, &networkErr)
+	require.Equal(t, codes.PermissionDenied, *networkErr.StatusCode)
+
+	client := newClient().SetNodeUnhealthyClassifier(func(err error) bool {
+		return status.Code(err) == codes.PermissionDenied || DefaultNodeUnhealthyClassifier(err)
+	})
+	resp, err := newTransfer().Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, AccountID{Account: 4}, resp.NodeID)
+
+	node, ok := client.network._GetNodeForAccountID(AccountID{Account: 3})
+	require.True(t, ok)
+	assert.False(t, node._IsHealthy())
+}
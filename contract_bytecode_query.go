@@ -83,6 +83,19 @@ func (q *ContractBytecodeQuery) SetMaxQueryPayment(maxPayment Hbar) *ContractByt
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *ContractBytecodeQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *ContractBytecodeQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *ContractBytecodeQuery) SetRequestStateProof(request bool) *ContractBytecodeQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *ContractBytecodeQuery) SetQueryPayment(paymentAmount Hbar) *ContractBytecodeQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -108,6 +121,12 @@ func (q *ContractBytecodeQuery) SetMaxBackoff(max time.Duration) *ContractByteco
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *ContractBytecodeQuery) SetSingleNode(singleNode bool) *ContractBytecodeQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *ContractBytecodeQuery) SetMinBackoff(min time.Duration) *ContractBytecodeQuery {
 	q.Query.SetMinBackoff(min)
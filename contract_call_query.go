@@ -152,6 +152,19 @@ func (q *ContractCallQuery) SetMaxQueryPayment(maxPayment Hbar) *ContractCallQue
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *ContractCallQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *ContractCallQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *ContractCallQuery) SetRequestStateProof(request bool) *ContractCallQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *ContractCallQuery) SetQueryPayment(paymentAmount Hbar) *ContractCallQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -177,6 +190,12 @@ func (q *ContractCallQuery) SetMaxBackoff(max time.Duration) *ContractCallQuery
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *ContractCallQuery) SetSingleNode(singleNode bool) *ContractCallQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *ContractCallQuery) SetMinBackoff(min time.Duration) *ContractCallQuery {
 	q.Query.SetMinBackoff(min)
@@ -352,6 +352,19 @@ func (tx *ContractCreateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *ContractCreateTransaction) SignWithSigner(signer Signer) *ContractCreateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this ContractCreateTransaction.
+func (tx *ContractCreateTransaction) SetSigningKeys(keys []PublicKey) *ContractCreateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *ContractCreateTransaction) AddSignature(publicKey PublicKey, signature []byte) *ContractCreateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -438,6 +451,12 @@ func (tx *ContractCreateTransaction) SetMaxBackoff(max time.Duration) *ContractC
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *ContractCreateTransaction) SetSingleNode(singleNode bool) *ContractCreateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *ContractCreateTransaction) SetMinBackoff(min time.Duration) *ContractCreateTransaction {
 	tx.Transaction.SetMinBackoff(min)
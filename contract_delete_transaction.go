@@ -156,6 +156,19 @@ func (tx *ContractDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *ContractDeleteTransaction) SignWithSigner(signer Signer) *ContractDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this ContractDeleteTransaction.
+func (tx *ContractDeleteTransaction) SetSigningKeys(keys []PublicKey) *ContractDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 func (tx *ContractDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *ContractDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
 	return tx
@@ -241,6 +254,12 @@ func (tx *ContractDeleteTransaction) SetMaxBackoff(max time.Duration) *ContractD
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *ContractDeleteTransaction) SetSingleNode(singleNode bool) *ContractDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *ContractDeleteTransaction) SetMinBackoff(min time.Duration) *ContractDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
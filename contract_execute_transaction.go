@@ -156,6 +156,19 @@ func (tx *ContractExecuteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *ContractExecuteTransaction) SignWithSigner(signer Signer) *ContractExecuteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this ContractExecuteTransaction.
+func (tx *ContractExecuteTransaction) SetSigningKeys(keys []PublicKey) *ContractExecuteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *ContractExecuteTransaction) AddSignature(publicKey PublicKey, signature []byte) *ContractExecuteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -242,6 +255,12 @@ func (tx *ContractExecuteTransaction) SetMaxBackoff(max time.Duration) *Contract
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *ContractExecuteTransaction) SetSingleNode(singleNode bool) *ContractExecuteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *ContractExecuteTransaction) SetMinBackoff(min time.Duration) *ContractExecuteTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -548,7 +548,7 @@ func (result *ContractFunctionResult) ToBytes() []byte {
 // ContractFunctionResultFromBytes returns a ContractFunctionResult from the protobuf encoded bytes of a ContractFunctionResult
 func ContractFunctionResultFromBytes(data []byte) (ContractFunctionResult, error) {
 	if data == nil {
-		return ContractFunctionResult{}, errByteArrayNull
+		return ContractFunctionResult{}, ErrByteArrayNull
 	}
 	pb := services.ContractFunctionResult{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -84,7 +84,7 @@ func (id *ContractID) ValidateChecksum(client *Client) error {
 			return err
 		}
 		if id.checksum == nil {
-			return errChecksumMissing
+			return ErrChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
 			networkName := NetworkNameOther
@@ -151,19 +151,12 @@ func (id ContractID) ToStringWithChecksum(client Client) (string, error) {
 	if id.EvmAddress != nil {
 		return "", errors.New("EvmAddress doesn't support checksums")
 	}
-	if client.GetNetworkName() == nil && client.GetLedgerID() == nil {
-		return "", errNetworkNameMissing
-	}
-	var checksum _ParseAddressResult
-	var err error
-	if client.network.ledgerID != nil {
-		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Contract))
-	}
+	checksum, err := _ChecksumForID(&client, id.Shard, id.Realm, id.Contract)
 	if err != nil {
 		return "", err
 	}
 
-	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Contract, checksum.correctChecksum), nil
+	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Contract, checksum), nil
 }
 
 // ToSolidityAddress returns the string representation of the ContractID as a _Solidity address.
@@ -162,3 +162,44 @@ func TestUnitContractIDPopulateFailWithNoMirror(t *testing.T) {
 	err = evmAddressAccountID.PopulateContract(client)
 	require.Error(t, err)
 }
+
+func TestUnitEntityIDSolidityAddressRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const packed = "0000000100000000000000020000000000000003"
+
+	accountID := AccountID{Shard: 1, Realm: 2, Account: 3}
+	require.Equal(t, packed, accountID.ToSolidityAddress())
+	parsedAccount, err := AccountIDFromSolidityAddress("0x" + packed)
+	require.NoError(t, err)
+	require.Equal(t, accountID, parsedAccount)
+
+	tokenID := TokenID{Shard: 1, Realm: 2, Token: 3}
+	require.Equal(t, packed, tokenID.ToSolidityAddress())
+	parsedToken, err := TokenIDFromSolidityAddress(packed)
+	require.NoError(t, err)
+	require.Equal(t, tokenID, parsedToken)
+
+	contractID := ContractID{Shard: 1, Realm: 2, Contract: 3}
+	require.Equal(t, packed, contractID.ToSolidityAddress())
+	parsedContract, err := ContractIDFromSolidityAddress(packed)
+	require.NoError(t, err)
+	require.Equal(t, contractID, parsedContract)
+
+	_, err = AccountIDFromSolidityAddress(packed[:38])
+	require.Error(t, err)
+	_, err = TokenIDFromSolidityAddress("0x" + packed + "00")
+	require.Error(t, err)
+	_, err = ContractIDFromSolidityAddress("not hex")
+	require.Error(t, err)
+
+	// An EVM address alias is not used; the address is always the packed shard, realm and number.
+	const evmAddress = "742d35cc6634c0532925a3b844bc454e4438f44e"
+	evmContractID, err := ContractIDFromEvmAddress(0, 0, evmAddress)
+	require.NoError(t, err)
+	require.Equal(t, "0000000000000000000000000000000000000000", evmContractID.ToSolidityAddress())
+
+	evmAccountID, err := AccountIDFromEvmAddress(0, 0, evmAddress)
+	require.NoError(t, err)
+	require.Equal(t, "0000000000000000000000000000000000000000", evmAccountID.ToSolidityAddress())
+}
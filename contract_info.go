@@ -46,7 +46,7 @@ type ContractInfo struct {
 
 func _ContractInfoFromProtobuf(contractInfo *services.ContractGetInfoResponse_ContractInfo) (ContractInfo, error) {
 	if contractInfo == nil {
-		return ContractInfo{}, errParameterNull
+		return ContractInfo{}, ErrParameterNull
 	}
 
 	var adminKey Key
@@ -133,7 +133,7 @@ func (contractInfo ContractInfo) ToBytes() []byte {
 // ContractInfoFromBytes returns a ContractInfo object deserialized from bytes
 func ContractInfoFromBytes(data []byte) (ContractInfo, error) {
 	if data == nil {
-		return ContractInfo{}, errByteArrayNull
+		return ContractInfo{}, ErrByteArrayNull
 	}
 	pb := services.ContractGetInfoResponse_ContractInfo{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -90,6 +90,19 @@ func (q *ContractInfoQuery) SetMaxQueryPayment(maxPayment Hbar) *ContractInfoQue
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *ContractInfoQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *ContractInfoQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *ContractInfoQuery) SetRequestStateProof(request bool) *ContractInfoQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *ContractInfoQuery) SetQueryPayment(paymentAmount Hbar) *ContractInfoQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -115,6 +128,12 @@ func (q *ContractInfoQuery) SetMaxBackoff(max time.Duration) *ContractInfoQuery
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *ContractInfoQuery) SetSingleNode(singleNode bool) *ContractInfoQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *ContractInfoQuery) SetMinBackoff(min time.Duration) *ContractInfoQuery {
 	q.Query.SetMinBackoff(min)
@@ -349,6 +349,19 @@ func (tx *ContractUpdateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *ContractUpdateTransaction) SignWithSigner(signer Signer) *ContractUpdateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this ContractUpdateTransaction.
+func (tx *ContractUpdateTransaction) SetSigningKeys(keys []PublicKey) *ContractUpdateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *ContractUpdateTransaction) AddSignature(publicKey PublicKey, signature []byte) *ContractUpdateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -435,6 +448,12 @@ func (tx *ContractUpdateTransaction) SetMaxBackoff(max time.Duration) *ContractU
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *ContractUpdateTransaction) SetSingleNode(singleNode bool) *ContractUpdateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *ContractUpdateTransaction) SetMinBackoff(min time.Duration) *ContractUpdateTransaction {
 	tx.Transaction.SetMinBackoff(min)
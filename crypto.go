@@ -21,11 +21,14 @@ package hedera
  */
 
 import (
+	"context"
 	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/binary"
 	"encoding/hex"
 	"io"
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -43,7 +46,7 @@ type Key interface {
 
 func _KeyFromProtobuf(pbKey *services.Key) (Key, error) {
 	if pbKey == nil {
-		return PublicKey{}, errParameterNull
+		return PublicKey{}, ErrParameterNull
 	}
 	switch key := pbKey.GetKey().(type) {
 	case *services.Key_Ed25519:
@@ -81,6 +84,52 @@ func _KeyFromProtobuf(pbKey *services.Key) (Key, error) {
 	}
 }
 
+// KeyFromProtobuf converts a protobuf Key received from elsewhere into a Key, checking that it is well formed:
+// key lists and threshold keys must not be empty, thresholds must be between 1 and the number of keys, and
+// every nested key must be well formed too.
+func KeyFromProtobuf(pbKey *services.Key) (Key, error) {
+	if err := _ValidateProtobufKey(pbKey); err != nil {
+		return nil, err
+	}
+
+	return _KeyFromProtobuf(pbKey)
+}
+
+func _ValidateProtobufKey(pbKey *services.Key) error {
+	if pbKey == nil {
+		return ErrParameterNull
+	}
+
+	switch key := pbKey.GetKey().(type) {
+	case *services.Key_ThresholdKey:
+		keys := key.ThresholdKey.GetKeys().GetKeys()
+		threshold := key.ThresholdKey.GetThreshold()
+		if threshold < 1 || int(threshold) > len(keys) {
+			return _NewErrBadKeyf("threshold %d is not between 1 and the number of keys %d", threshold, len(keys))
+		}
+		return _ValidateProtobufKeys(keys)
+	case *services.Key_KeyList:
+		if len(key.KeyList.GetKeys()) == 0 {
+			return _NewErrBadKeyf("key list is empty")
+		}
+		return _ValidateProtobufKeys(key.KeyList.GetKeys())
+	case nil:
+		return _NewErrBadKeyf("key is not set")
+	}
+
+	return nil
+}
+
+func _ValidateProtobufKeys(pbKeys []*services.Key) error {
+	for _, pbKey := range pbKeys {
+		if err := _ValidateProtobufKey(pbKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type PrivateKey struct {
 	ecdsaPrivateKey   *_ECDSAPrivateKey
 	ed25519PrivateKey *_Ed25519PrivateKey
@@ -118,6 +167,16 @@ func PrivateKeyGenerateEd25519() (PrivateKey, error) {
 	}, nil
 }
 
+// PrivateKeyGenerateFromSeed deterministically derives an Ed25519 key from seed, so the same seed always yields
+// the same key. It is meant for pinning keys in tests; use PrivateKeyGenerateEd25519 for real keys.
+func PrivateKeyGenerateFromSeed(seed []byte) (PrivateKey, error) {
+	if len(seed) == 0 {
+		return PrivateKey{}, errors.New("seed must not be empty")
+	}
+
+	return PrivateKeyFromSeedEd25519(seed)
+}
+
 // Deprecated the use of raw bytes for a Ed25519 private key is deprecated; use PrivateKeyFromBytesEd25519() instead.
 func PrivateKeyFromBytes(bytes []byte) (PrivateKey, error) {
 	key, err := _Ed25519PrivateKeyFromBytes(bytes)
@@ -188,6 +247,8 @@ func PublicKeyFromBytesEd25519(bytes []byte) (PublicKey, error) {
 	}, nil
 }
 
+// PublicKeyFromBytesECDSA parses an ECDSA (secp256k1) public key from its 33 byte compressed form, its 65 byte
+// uncompressed form (as used by EVM tooling), or its DER encoding. The point must be on the curve.
 func PublicKeyFromBytesECDSA(bytes []byte) (PublicKey, error) {
 	key, err := _ECDSAPublicKeyFromBytes(bytes)
 	if err != nil {
@@ -199,6 +260,32 @@ func PublicKeyFromBytesECDSA(bytes []byte) (PublicKey, error) {
 	}, nil
 }
 
+// PublicKeyRecoverFromSignatureECDSA recovers the ECDSA (secp256k1) public key which produced signature over the
+// 32 byte message hash, typically a Keccak-256 hash. The signature must be in the 65 byte [R || S || V] form, where
+// V is the recovery ID as either 0/1 or the Ethereum style 27/28.
+func PublicKeyRecoverFromSignatureECDSA(hash []byte, signature []byte) (PublicKey, error) {
+	if len(hash) != 32 {
+		return PublicKey{}, _NewErrBadKeyf("invalid message hash length: %v bytes", len(hash))
+	}
+
+	if len(signature) != 65 {
+		return PublicKey{}, _NewErrBadKeyf("invalid signature length: %v bytes", len(signature))
+	}
+
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	recovered, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return PublicKey{}, _NewErrBadKeyf("failed to recover public key: %v", err)
+	}
+
+	return PublicKeyFromBytesECDSA(crypto.CompressPubkey(recovered))
+}
+
 // Deprecated the use of raw bytes for a Ed25519 private key is deprecated; use PublicKeyFromBytesEd25519() instead.
 func PublicKeyFromBytes(bytes []byte) (PublicKey, error) {
 	key, err := _Ed25519PublicKeyFromBytes(bytes)
@@ -253,6 +340,62 @@ func PrivateKeyFromMnemonic(mnemonic Mnemonic, passPhrase string) (PrivateKey, e
 	}, nil
 }
 
+// PrivateKeyFromMnemonicWithPath recovers a private key from a mnemonic phrase and passphrase by deriving along a
+// BIP-32 path such as "m/44'/3030'/0'/0'/0'", as shown by hardware wallets. Ed25519 only supports hardened
+// derivation, so a path whose segments are all hardened gives an Ed25519 key, while a path with any non-hardened
+// segment, such as "m/44'/3030'/0'/0/0", gives an ECDSA secp256k1 key. Malformed paths and out of range indices
+// return ErrInvalidDerivationPath.
+func PrivateKeyFromMnemonicWithPath(mnemonic Mnemonic, passPhrase string, path string) (PrivateKey, error) {
+	indices, err := _ParseDerivationPath(path)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	for _, index := range indices {
+		if !IsHardenedIndex(index) {
+			key, err := mnemonic._ToECDSAsecp256k1PrivateKey(passPhrase, indices)
+			if err != nil {
+				return PrivateKey{}, err
+			}
+
+			return PrivateKey{
+				ecdsaPrivateKey: key,
+			}, nil
+		}
+	}
+
+	for i, index := range indices {
+		indices[i] = index &^ hardenedBit
+	}
+
+	key, err := _Ed25519PrivateKeyFromMnemonicPath(mnemonic, passPhrase, indices)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	return PrivateKey{
+		ed25519PrivateKey: key,
+	}, nil
+}
+
+// PrivateKeyFromMnemonicECDSA recovers an ECDSA secp256k1 private key from a mnemonic phrase and passphrase along
+// the standard Ethereum path m/44'/60'/0'/0/0, giving the same first account as MetaMask and hardware wallets.
+func PrivateKeyFromMnemonicECDSA(mnemonic Mnemonic, passPhrase string) (PrivateKey, error) {
+	key, err := mnemonic._ToECDSAsecp256k1PrivateKey(passPhrase, []uint32{
+		ToHardenedIndex(44),
+		ToHardenedIndex(60),
+		ToHardenedIndex(0),
+		0,
+		0})
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	return PrivateKey{
+		ecdsaPrivateKey: key,
+	}, nil
+}
+
 // The use of raw bytes for a Ed25519 private key is deprecated; use PrivateKeyFromStringEd25519() instead.
 func PrivateKeyFromString(s string) (PrivateKey, error) {
 	byt, err := hex.DecodeString(s)
@@ -707,6 +850,26 @@ func (pk PublicKey) BytesRaw() []byte {
 	return []byte{}
 }
 
+// ToBytesCompressed returns the 33 byte compressed form of an ECDSA (secp256k1) public key,
+// which is the form Hedera uses. It returns an empty slice for other key types.
+func (pk PublicKey) ToBytesCompressed() []byte {
+	if pk.ecdsaPublicKey != nil {
+		return pk.ecdsaPublicKey._BytesRaw()
+	}
+
+	return []byte{}
+}
+
+// ToBytesUncompressed returns the 65 byte uncompressed form (0x04 || X || Y) of an ECDSA (secp256k1) public key,
+// as used by EVM tooling. It returns an empty slice for other key types.
+func (pk PublicKey) ToBytesUncompressed() []byte {
+	if pk.ecdsaPublicKey != nil {
+		return pk.ecdsaPublicKey._ToFullKey()
+	}
+
+	return []byte{}
+}
+
 func (pk PublicKey) BytesDer() []byte {
 	if pk.ecdsaPublicKey != nil {
 		return pk.ecdsaPublicKey._BytesDer()
@@ -727,6 +890,21 @@ func (sk PrivateKey) Keystore(passphrase string) ([]byte, error) {
 	return []byte{}, errors.New("only ed25519 keystore is supported right now")
 }
 
+// ToEncryptedPem encodes the key as a PKCS#8 ENCRYPTED PRIVATE KEY PEM block protected by passphrase, which
+// PrivateKeyFromPem reads back with the same passphrase. Only Ed25519 keys are supported, since PKCS#8 has no
+// standard encoding for secp256k1 keys.
+func (sk PrivateKey) ToEncryptedPem(passphrase string) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return []byte{}, errors.New("a passphrase is required to encrypt the pem")
+	}
+
+	if sk.ed25519PrivateKey != nil {
+		return sk.ed25519PrivateKey._ToEncryptedPem(passphrase)
+	}
+
+	return []byte{}, errors.New("only ed25519 encrypted pem is supported right now")
+}
+
 func (sk PrivateKey) WriteKeystore(destination io.Writer, passphrase string) error {
 	if sk.ed25519PrivateKey != nil {
 		return sk.ed25519PrivateKey._WriteKeystore(destination, passphrase)
@@ -747,6 +925,30 @@ func (sk PrivateKey) Sign(message []byte) []byte {
 	return []byte{}
 }
 
+// SignWithDomain signs a message bound to a domain tag, so that a signature made for one protocol cannot
+// be replayed as a signature for another. The signed payload is
+//
+//	SHA-256(uint32_be(len(domain)) || domain || message)
+//
+// where domain is UTF-8 encoded and its length is in bytes. The 32 byte digest is then signed the same
+// way as Sign signs a message: as is for Ed25519 keys, and hashed again with Keccak-256 for ECDSA
+// (secp256k1) keys. Use VerifyWithDomain with the same domain to verify the signature.
+func (sk PrivateKey) SignWithDomain(domain string, message []byte) []byte {
+	return sk.Sign(_DomainSeparatedDigest(domain, message))
+}
+
+func _DomainSeparatedDigest(domain string, message []byte) []byte {
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(len(domain)))
+
+	hash := sha256.New()
+	hash.Write(length)
+	hash.Write([]byte(domain))
+	hash.Write(message)
+
+	return hash.Sum(nil)
+}
+
 func (sk PrivateKey) SupportsDerivation() bool {
 	if sk.ed25519PrivateKey != nil {
 		return sk.ed25519PrivateKey._SupportsDerivation()
@@ -780,6 +982,29 @@ func (sk PrivateKey) Derive(index uint32) (PrivateKey, error) {
 	return PrivateKey{}, nil
 }
 
+// DeriveRange derives the child keys for the indexes [start, start+count), in order, such as when scanning a
+// wallet for funded accounts. Every child is derived from this key on its own, so there is no derivation state to
+// share between them and it costs the same as calling Derive for each index. Unlike a plain uint32 loop, it
+// rejects a range running past the last index instead of wrapping around to index 0, and it returns no keys
+// when any index cannot be derived.
+func (sk PrivateKey) DeriveRange(start uint32, count uint32) ([]PrivateKey, error) {
+	if uint64(start)+uint64(count) > uint64(math.MaxUint32)+1 {
+		return nil, errors.New("derivation range exceeds the maximum index")
+	}
+
+	var keys []PrivateKey
+	for i := uint32(0); i < count; i++ {
+		key, err := sk.Derive(start + i)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
 func (sk PrivateKey) LegacyDerive(index int64) (PrivateKey, error) {
 	if sk.ed25519PrivateKey != nil {
 		key, err := sk.ed25519PrivateKey._LegacyDerive(index)
@@ -819,6 +1044,11 @@ func (pk PublicKey) _ToProtoKey() *services.Key {
 	return &services.Key{}
 }
 
+// ToProtobufKey returns the key as a protobuf Key, for integrations which pass keys around in their protobuf form.
+func (pk PublicKey) ToProtobufKey() *services.Key {
+	return pk._ToProtoKey()
+}
+
 func (pk PublicKey) _ToSignaturePairProtobuf(signature []byte) *services.SignaturePair {
 	if pk.ecdsaPublicKey != nil {
 		return pk.ecdsaPublicKey._ToSignaturePairProtobuf(signature)
@@ -853,6 +1083,47 @@ func (sk PrivateKey) SignTransaction(tx *Transaction) ([]byte, error) {
 	return []byte{}, errors.New("key type not supported, only ed25519 and ECDSASecp256K1 are supported right now")
 }
 
+// SignTransactionWithContext signs each of the transaction's node bodies with this key, checking ctx before
+// each one so a slow signer can be abandoned. Signatures are only attached once every body is signed; if ctx
+// is done first, the transaction is left unchanged and ctx.Err() is returned. The first body's signature is
+// returned.
+func (sk PrivateKey) SignTransactionWithContext(ctx context.Context, tx *Transaction) ([]byte, error) {
+	if sk.ecdsaPrivateKey == nil && sk.ed25519PrivateKey == nil {
+		return []byte{}, errors.New("key type not supported, only ed25519 and ECDSASecp256K1 are supported right now")
+	}
+
+	if tx.signedTransactions._Length() == 0 {
+		return []byte{}, ErrTransactionIsNotFrozen
+	}
+
+	publicKey := sk.PublicKey()
+	if tx._KeyAlreadySigned(publicKey) {
+		return []byte{}, nil
+	}
+
+	signatures := make([][]byte, tx.signedTransactions._Length())
+	for index := range signatures {
+		if err := ctx.Err(); err != nil {
+			return []byte{}, err
+		}
+
+		signatures[index] = sk.Sign(tx.signedTransactions._Get(index).(*services.SignedTransaction).GetBodyBytes())
+	}
+
+	tx.transactions = _NewLockableSlice()
+	tx.publicKeys = append(tx.publicKeys, publicKey)
+	tx.transactionSigners = append(tx.transactionSigners, nil)
+	tx.transactionIDs.locked = true
+
+	for index, signature := range signatures {
+		temp := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+		temp.SigMap.SigPair = append(temp.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signature))
+		tx.signedTransactions._Set(index, temp)
+	}
+
+	return signatures[0], nil
+}
+
 func (pk PublicKey) Verify(message []byte, signature []byte) bool {
 	if pk.ecdsaPublicKey != nil {
 		return pk.ecdsaPublicKey._Verify(message, signature)
@@ -865,6 +1136,17 @@ func (pk PublicKey) Verify(message []byte, signature []byte) bool {
 	return false
 }
 
+// VerifyWithDomain verifies a signature made with SignWithDomain for the same domain and message.
+func (pk PublicKey) VerifyWithDomain(domain string, message []byte, signature []byte) bool {
+	digest := _DomainSeparatedDigest(domain, message)
+
+	if pk.ecdsaPublicKey != nil {
+		return pk.ecdsaPublicKey._Verify(crypto.Keccak256(digest), signature)
+	}
+
+	return pk.Verify(digest, signature)
+}
+
 func (pk PublicKey) VerifyTransaction(transaction Transaction) bool {
 	if pk.ecdsaPublicKey != nil {
 		return pk.ecdsaPublicKey._VerifyTransaction(transaction)
@@ -83,7 +83,7 @@ func (fee *CustomFee) GetAllCollectorsAreExempt() bool {
 // CustomFeeFromBytes returns a CustomFee from a raw protobuf byte array
 func CustomFeeFromBytes(data []byte) (Fee, error) {
 	if data == nil {
-		return nil, errByteArrayNull
+		return nil, ErrByteArrayNull
 	}
 	pb := services.CustomFee{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -170,22 +170,18 @@ func (sk _ECDSAPrivateKey) _PublicKey() *_ECDSAPublicKey {
 		x, y := crypto.S256().ScalarBaseMult(b)
 		sk.keyData.X = x
 		sk.keyData.Y = y
-		return &_ECDSAPublicKey{
-			&ecdsa.PublicKey{
-				Curve: crypto.S256(),
-				X:     x,
-				Y:     y,
-			},
-		}
-	}
-
-	return &_ECDSAPublicKey{
-		&ecdsa.PublicKey{
-			Curve: sk.keyData.Curve,
-			X:     sk.keyData.X,
-			Y:     sk.keyData.Y,
-		},
-	}
+		return _NewECDSAPublicKey(&ecdsa.PublicKey{
+			Curve: crypto.S256(),
+			X:     x,
+			Y:     y,
+		})
+	}
+
+	return _NewECDSAPublicKey(&ecdsa.PublicKey{
+		Curve: sk.keyData.Curve,
+		X:     sk.keyData.X,
+		Y:     sk.keyData.Y,
+	})
 }
 
 func _ECDSAPrivateKeyFromPem(bytes []byte, passphrase string) (*_ECDSAPrivateKey, error) {
@@ -312,7 +308,7 @@ func (sk _ECDSAPrivateKey) _SignTransaction(tx *Transaction) ([]byte, error) {
 	tx._RequireOneNodeAccountID()
 
 	if tx.signedTransactions._Length() == 0 {
-		return make([]byte, 0), errTransactionRequiresSingleNodeAccountID
+		return make([]byte, 0), ErrTransactionRequiresSingleNodeAccountID
 	}
 
 	signature := sk._Sign(tx.signedTransactions._Get(0).(*services.SignedTransaction).GetBodyBytes())
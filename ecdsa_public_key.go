@@ -28,6 +28,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"strings"
+	"sync"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -37,6 +38,21 @@ import (
 
 type _ECDSAPublicKey struct {
 	*ecdsa.PublicKey
+	// addressCache holds the Ethereum address once derived; every key parsed or
+	// derived gets its own cache, so a reconstructed key never sees a stale address.
+	addressCache *_ECDSAAddressCache
+}
+
+type _ECDSAAddressCache struct {
+	once    sync.Once
+	address string
+}
+
+func _NewECDSAPublicKey(key *ecdsa.PublicKey) *_ECDSAPublicKey {
+	return &_ECDSAPublicKey{
+		PublicKey:    key,
+		addressCache: &_ECDSAAddressCache{},
+	}
 }
 
 const _LegacyECDSAPubKeyPrefix = "302d300706052b8104000a032200"
@@ -46,6 +62,8 @@ func _ECDSAPublicKeyFromBytes(byt []byte) (*_ECDSAPublicKey, error) {
 	switch length {
 	case 33:
 		return _ECDSAPublicKeyFromBytesRaw(byt)
+	case 65:
+		return _ECDSAPublicKeyFromBytesUncompressed(byt)
 	case 47:
 		return _LegacyECDSAPublicKeyFromBytesDer(byt)
 	case 56:
@@ -57,7 +75,7 @@ func _ECDSAPublicKeyFromBytes(byt []byte) (*_ECDSAPublicKey, error) {
 
 func _ECDSAPublicKeyFromBytesRaw(byt []byte) (*_ECDSAPublicKey, error) {
 	if byt == nil {
-		return &_ECDSAPublicKey{}, errByteArrayNull
+		return &_ECDSAPublicKey{}, ErrByteArrayNull
 	}
 	if len(byt) != 33 {
 		return &_ECDSAPublicKey{}, _NewErrBadKeyf("invalid public key length: %v bytes", len(byt))
@@ -68,14 +86,30 @@ func _ECDSAPublicKeyFromBytesRaw(byt []byte) (*_ECDSAPublicKey, error) {
 		return &_ECDSAPublicKey{}, err
 	}
 
-	return &_ECDSAPublicKey{
-		key,
-	}, nil
+	return _NewECDSAPublicKey(key), nil
+}
+
+// _ECDSAPublicKeyFromBytesUncompressed parses a 65 byte uncompressed (0x04 || X || Y) public key,
+// as used by EVM tooling. The point must be on the secp256k1 curve.
+func _ECDSAPublicKeyFromBytesUncompressed(byt []byte) (*_ECDSAPublicKey, error) {
+	if byt == nil {
+		return &_ECDSAPublicKey{}, ErrByteArrayNull
+	}
+	if len(byt) != 65 {
+		return &_ECDSAPublicKey{}, _NewErrBadKeyf("invalid uncompressed public key length: %v bytes", len(byt))
+	}
+
+	key, err := crypto.UnmarshalPubkey(byt)
+	if err != nil {
+		return &_ECDSAPublicKey{}, _NewErrBadKeyf("invalid uncompressed public key: %v", err)
+	}
+
+	return _NewECDSAPublicKey(key), nil
 }
 
 func _LegacyECDSAPublicKeyFromBytesDer(byt []byte) (*_ECDSAPublicKey, error) {
 	if byt == nil {
-		return &_ECDSAPublicKey{}, errByteArrayNull
+		return &_ECDSAPublicKey{}, ErrByteArrayNull
 	}
 
 	given := hex.EncodeToString(byt)
@@ -94,13 +128,11 @@ func _LegacyECDSAPublicKeyFromBytesDer(byt []byte) (*_ECDSAPublicKey, error) {
 		return &_ECDSAPublicKey{}, err
 	}
 
-	return &_ECDSAPublicKey{
-		key,
-	}, nil
+	return _NewECDSAPublicKey(key), nil
 }
 func _ECDSAPublicKeyFromBytesDer(byt []byte) (*_ECDSAPublicKey, error) {
 	if byt == nil {
-		return &_ECDSAPublicKey{}, errByteArrayNull
+		return &_ECDSAPublicKey{}, ErrByteArrayNull
 	}
 
 	type AlgorithmIdentifier struct {
@@ -162,9 +194,7 @@ func _ECDSAPublicKeyFromBytesDer(byt []byte) (*_ECDSAPublicKey, error) {
 		return nil, errors.New("public key is not on the curve")
 	}
 
-	return &_ECDSAPublicKey{
-		PublicKey: pubKey,
-	}, nil
+	return _NewECDSAPublicKey(pubKey), nil
 }
 
 func _ECDSAPublicKeyFromString(s string) (*_ECDSAPublicKey, error) {
@@ -271,6 +301,18 @@ func (pk _ECDSAPublicKey) _ToFullKey() []byte {
 }
 
 func (pk _ECDSAPublicKey) _ToEthereumAddress() string {
+	if pk.addressCache == nil {
+		return pk._DeriveEthereumAddress()
+	}
+
+	pk.addressCache.once.Do(func() {
+		pk.addressCache.address = pk._DeriveEthereumAddress()
+	})
+
+	return pk.addressCache.address
+}
+
+func (pk _ECDSAPublicKey) _DeriveEthereumAddress() string {
 	temp := pk._ToFullKey()[1:]
 	hash := crypto.Keccak256(temp)
 	return hex.EncodeToString(hash[12:])
@@ -139,6 +139,12 @@ func _Ed25519PrivateKeyFromSeed(seed []byte) (*_Ed25519PrivateKey, error) {
 // An empty string can be passed for passPhrase If the mnemonic phrase wasn't generated with a passphrase. This is
 // required to recover a private key from a mnemonic generated by the Android and iOS wallets.
 func _Ed25519PrivateKeyFromMnemonic(mnemonic Mnemonic, passPhrase string) (*_Ed25519PrivateKey, error) {
+	return _Ed25519PrivateKeyFromMnemonicPath(mnemonic, passPhrase, []uint32{44, 3030, 0, 0})
+}
+
+// _Ed25519PrivateKeyFromMnemonicPath derives the key along path from the mnemonic's master key. The indices
+// are not pre-hardened; every step is hardened, as Ed25519 derivation requires.
+func _Ed25519PrivateKeyFromMnemonicPath(mnemonic Mnemonic, passPhrase string, path []uint32) (*_Ed25519PrivateKey, error) {
 	seed := mnemonic._ToSeed(passPhrase)
 	keyFromSeed, err := _Ed25519PrivateKeyFromSeed(seed)
 	if err != nil {
@@ -149,7 +155,7 @@ func _Ed25519PrivateKeyFromMnemonic(mnemonic Mnemonic, passPhrase string) (*_Ed2
 	chainCode := keyFromSeed.chainCode
 
 	// note the index is for derivation, not the index of the slice
-	for _, index := range []uint32{44, 3030, 0, 0} {
+	for _, index := range path {
 		keyBytes, chainCode, err = _DeriveEd25519ChildKey(keyBytes, chainCode, index)
 		if err != nil {
 			return nil, err
@@ -243,7 +249,21 @@ func _Ed25519PrivateKeyFromPem(bytes []byte, passphrase string) (*_Ed25519Privat
 		return &_Ed25519PrivateKey{}, err
 	}
 
-	return _Ed25519PrivateKeyFromBytes(keyI.(ed25519.PrivateKey))
+	key, ok := keyI.(ed25519.PrivateKey)
+	if !ok {
+		return &_Ed25519PrivateKey{}, _NewErrBadKeyf("pem file did not contain an ed25519 private key")
+	}
+
+	return _Ed25519PrivateKeyFromBytes(key)
+}
+
+func (sk _Ed25519PrivateKey) _ToEncryptedPem(passphrase string) ([]byte, error) {
+	der, err := pkcs8.MarshalPrivateKey(ed25519.PrivateKey(sk.keyData), []byte(passphrase), nil)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der}), nil
 }
 
 func _Ed25519PrivateKeyReadPem(source io.Reader, passphrase string) (*_Ed25519PrivateKey, error) {
@@ -382,7 +402,7 @@ func (sk _Ed25519PrivateKey) _SignTransaction(tx *Transaction) ([]byte, error) {
 	tx._RequireOneNodeAccountID()
 
 	if tx.signedTransactions._Length() == 0 {
-		return make([]byte, 0), errTransactionRequiresSingleNodeAccountID
+		return make([]byte, 0), ErrTransactionRequiresSingleNodeAccountID
 	}
 
 	signature := sk._Sign(tx.signedTransactions._Get(0).(*services.SignedTransaction).GetBodyBytes())
@@ -62,7 +62,7 @@ func _Ed25519PublicKeyFromBytes(bytes []byte) (*_Ed25519PublicKey, error) {
 // _Ed25519PublicKeyFromBytes constructs a known _Ed25519PublicKey from its text-encoded representation.
 func _Ed25519PublicKeyFromBytesRaw(bytes []byte) (*_Ed25519PublicKey, error) {
 	if bytes == nil {
-		return &_Ed25519PublicKey{}, errByteArrayNull
+		return &_Ed25519PublicKey{}, ErrByteArrayNull
 	}
 	if len(bytes) != ed25519.PublicKeySize {
 		return &_Ed25519PublicKey{}, _NewErrBadKeyf("invalid public key length: %v bytes", len(bytes))
@@ -59,6 +59,26 @@ func _ChecksumVerify(num int) error {
 	}
 }
 
+// _ChecksumForID returns the checksum of the ID shard.realm.num on the client's network, or ErrNetworkNameMissing
+// if the client does not know which network it is for.
+func _ChecksumForID(client *Client, shard uint64, realm uint64, num uint64) (string, error) {
+	if client == nil {
+		return "", ErrNetworkNameMissing
+	}
+
+	ledgerID := client.GetLedgerID()
+	if ledgerID == nil || len(ledgerID._LedgerIDBytes) == 0 {
+		return "", ErrNetworkNameMissing
+	}
+
+	result, err := _ChecksumParseAddress(ledgerID, fmt.Sprintf("%d.%d.%d", shard, realm, num))
+	if err != nil {
+		return "", err
+	}
+
+	return result.correctChecksum, nil
+}
+
 func _ChecksumParseAddress(ledgerID *LedgerID, address string) (_ParseAddressResult, error) {
 	var err error
 	match := regexp.MustCompile(`(0|(?:[1-9]\d*))\.(0|(?:[1-9]\d*))\.(0|(?:[1-9]\d*))(?:-([a-z]{5}))?$`)
@@ -23,6 +23,8 @@ package hedera
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	// "reflect"
 
@@ -34,21 +36,31 @@ type ErrMaxChunksExceeded struct {
 	MaxChunks uint64
 }
 
-var errTransactionIsFrozen = errors.New("transaction is immutable; it has at least one signature or has been explicitly frozen")
-var errNoClientOrTransactionID = errors.New("`client` must have an `_Operator` or `transactionId` must be set")
-var errNoClientOrTransactionIDOrNodeId = errors.New("`client` must be provided or both `nodeId` and `transactionId` must be set") // nolint
-var errClientOperatorSigning = errors.New("`client` must have an `_Operator` to sign with the _Operator")
-var errNoClientProvided = errors.New("`client` must be provided and have an _Operator")
-var errTransactionIsNotFrozen = errors.New("transaction is not frozen")
-var errFailedToDeserializeBytes = errors.New("failed to deserialize bytes")
-var errNoTransactionInBytes = errors.New("no transaction was found in bytes")
-var errTransactionRequiresSingleNodeAccountID = errors.New("`PrivateKey.SignTransaction()` requires `Transaction` to have a single _Node `AccountID` set")
-var errNoTransactions = errors.New("no transactions to execute")
-var errByteArrayNull = errors.New("byte array can't be null")
-var errParameterNull = errors.New("the parameter can't be null")
-var errNetworkNameMissing = errors.New("can't derive checksum for ID without knowing which _Network the ID is for")
-var errChecksumMissing = errors.New("no checksum provided")
-var errLockedSlice = errors.New("slice is locked")
+// Sentinel errors returned by transaction and query paths. They may be wrapped, so compare them with errors.Is.
+var ErrTransactionIsFrozen = errors.New("transaction is immutable; it has at least one signature or has been explicitly frozen")
+var ErrNoClientOrTransactionID = errors.New("`client` must have an `_Operator` or `transactionId` must be set")
+var ErrNoClientOrTransactionIDOrNodeID = errors.New("`client` must be provided or both `nodeId` and `transactionId` must be set")
+var ErrClientOperatorSigning = errors.New("`client` must have an `_Operator` to sign with the _Operator")
+var ErrNoClientProvided = errors.New("`client` must be provided and have an _Operator")
+var ErrNoOperator = fmt.Errorf("%w: no _Operator is set; set one with SetOperator to pay for queries and sign transactions", ErrNoClientProvided)
+var ErrTransactionIsNotFrozen = errors.New("transaction is not frozen")
+var ErrFailedToDeserializeBytes = errors.New("failed to deserialize bytes")
+var ErrNoTransactionInBytes = errors.New("no transaction was found in bytes")
+var ErrTransactionRequiresSingleNodeAccountID = errors.New("`PrivateKey.SignTransaction()` requires `Transaction` to have a single _Node `AccountID` set")
+var ErrNoTransactions = errors.New("no transactions to execute")
+var ErrTransactionBodiesMismatch = errors.New("failed to validate transaction bodies")
+var ErrByteArrayNull = errors.New("byte array can't be null")
+var ErrParameterNull = errors.New("the parameter can't be null")
+var ErrNetworkNameMissing = errors.New("can't derive checksum for ID without knowing which _Network the ID is for")
+var ErrChecksumMissing = errors.New("no checksum provided")
+var ErrLockedSlice = errors.New("slice is locked")
+var ErrNoSignatures = errors.New("transaction has no signatures and the client does not auto-sign with the operator; sign it before executing or the network rejects it with INVALID_SIGNATURE")
+var ErrSchedulableBodyNotTransfer = errors.New("schedulable transaction body does not contain a crypto transfer")
+var ErrMnemonicChecksumMismatch = errors.New("mnemonic checksum does not match its words")
+var ErrAutoRenewPeriodNotSet = errors.New("an auto-renew account is set but no auto-renew period is set")
+var ErrSignatureMergeBodyMismatch = errors.New("cannot merge signatures from a transaction with different body bytes")
+var ErrStateProofNotReturned = errors.New("a state proof was requested but the node did not return one, state proofs may not be supported by this network")
+var ErrWaitForExpiryWithoutExpirationTime = errors.New("wait for expiry is set but no expiration time is set")
 
 type ErrInvalidNodeAccountIDSet struct {
 	NodeAccountID AccountID
@@ -97,6 +109,7 @@ func (e ErrBadKey) Error() string {
 }
 
 // ErrHederaNetwork is returned in cases where the Hedera _Network cannot be reached or a _Network-side error occurs.
+// When a gRPC call fails with a status code that is not retried, Execute's error wraps it, so use errors.As.
 type ErrHederaNetwork struct {
 	error error
 	// GRPC Status Code
@@ -108,11 +121,18 @@ func (e ErrHederaNetwork) Error() string {
 	return fmt.Sprintf("transport error occurred while accessing the Hedera _Network: %s", e.error)
 }
 
+// Unwrap returns the underlying transport error
+func (e ErrHederaNetwork) Unwrap() error {
+	return e.error
+}
+
 // ErrHederaPreCheckStatus is returned by Transaction.Execute and QueryBuilder.Execute if an exceptional status is
 // returned during _Network side validation of the sent transaction.
 type ErrHederaPreCheckStatus struct {
 	TxID   TransactionID
 	Status Status
+	// set to an ErrMaxAttemptsExceeded when Execute gave up retrying this status
+	attempts error
 }
 
 // Error() implements the Error interface
@@ -126,6 +146,18 @@ func (e ErrHederaPreCheckStatus) Error() string {
 	return fmt.Sprintf("exceptional precheck status %s received for transaction %v", e.Status.String(), e.TxID)
 }
 
+// Unwrap returns an ErrMaxAttemptsExceeded when Execute gave up after retrying this status, otherwise nil.
+func (e ErrHederaPreCheckStatus) Unwrap() error {
+	return e.attempts
+}
+
+// Is reports whether target is an ErrHederaPreCheckStatus with the same status, so that
+// errors.Is(err, ErrHederaPreCheckStatus{Status: StatusInsufficientPayerBalance}) matches regardless of the transaction ID.
+func (e ErrHederaPreCheckStatus) Is(target error) bool {
+	t, ok := target.(ErrHederaPreCheckStatus)
+	return ok && t.Status == e.Status
+}
+
 // ErrHederaReceiptStatus is returned by TransactionID.GetReceipt if the status of the receipt is exceptional.
 type ErrHederaReceiptStatus struct {
 	TxID    TransactionID
@@ -142,6 +174,12 @@ func (e ErrHederaReceiptStatus) Error() string {
 	return fmt.Sprintf("exceptional receipt status: %s", e.Status.String())
 }
 
+// Is reports whether target is an ErrHederaReceiptStatus with the same status.
+func (e ErrHederaReceiptStatus) Is(target error) bool {
+	t, ok := target.(ErrHederaReceiptStatus)
+	return ok && t.Status == e.Status
+}
+
 // ErrHederaRecordStatus is returned by TransactionID.GetRecord if the status of the record is exceptional.
 type ErrHederaRecordStatus struct {
 	TxID   TransactionID
@@ -153,6 +191,12 @@ func (e ErrHederaRecordStatus) Error() string {
 	return fmt.Sprintf("exceptional precheck status %s", e.Status.String())
 }
 
+// Is reports whether target is an ErrHederaRecordStatus with the same status.
+func (e ErrHederaRecordStatus) Is(target error) bool {
+	t, ok := target.(ErrHederaRecordStatus)
+	return ok && t.Status == e.Status
+}
+
 // ErrLocalValidation is returned by TransactionBuilder.Build(*Client) and QueryBuilder.Execute(*Client)
 // if the constructed transaction or query fails local sanity checks.
 type ErrLocalValidation struct {
@@ -163,3 +207,342 @@ type ErrLocalValidation struct {
 func (e ErrLocalValidation) Error() string {
 	return e.message
 }
+
+// ErrFreezeFailed is returned when a transaction could not be frozen, either by an explicit
+// Freeze/FreezeWith call or implicitly while executing.
+type ErrFreezeFailed struct {
+	Err error
+}
+
+// Error() implements the Error interface
+func (e ErrFreezeFailed) Error() string {
+	return fmt.Sprintf("failed to freeze transaction: %s", e.Err)
+}
+
+// Unwrap returns the error which caused the freeze to fail
+func (e ErrFreezeFailed) Unwrap() error {
+	return e.Err
+}
+
+// ErrSerialization is returned when a transaction or query could not be converted to or from its protobuf encoding.
+type ErrSerialization struct {
+	Message string
+	Err     error
+}
+
+// Error() implements the Error interface
+func (e ErrSerialization) Error() string {
+	return fmt.Sprintf("%s: %s", e.Message, e.Err)
+}
+
+// Unwrap returns the underlying encoding error
+func (e ErrSerialization) Unwrap() error {
+	return e.Err
+}
+
+// ErrMaxAttemptsExceeded records that Execute gave up after every attempt failed with a retryable status. It is
+// found with errors.As on the ErrHederaPreCheckStatus that Execute returns, which keeps its own type.
+type ErrMaxAttemptsExceeded struct {
+	Attempts    int
+	MaxAttempts int
+	// The error returned by the last attempt, if it isn't the error wrapping this one
+	Err error
+}
+
+// Error() implements the Error interface
+func (e ErrMaxAttemptsExceeded) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("retry %d/%d", e.Attempts, e.MaxAttempts)
+	}
+
+	return fmt.Sprintf("retry %d/%d: %s", e.Attempts, e.MaxAttempts, e.Err)
+}
+
+// Unwrap returns the error returned by the last attempt
+func (e ErrMaxAttemptsExceeded) Unwrap() error {
+	return e.Err
+}
+
+// ErrReceiptTimeout is returned by TransactionReceiptQuery.Execute when the receipt was still not
+// available after the timeout set with SetTimeout.
+type ErrReceiptTimeout struct {
+	// How long the query polled for the receipt
+	Elapsed time.Duration
+	Timeout time.Duration
+	// The error returned by the last attempt
+	Err error
+}
+
+// Error() implements the Error interface
+func (e ErrReceiptTimeout) Error() string {
+	return fmt.Sprintf("receipt not available after polling for %s (timeout %s): %s", e.Elapsed, e.Timeout, e.Err)
+}
+
+// Unwrap returns the error returned by the last attempt
+func (e ErrReceiptTimeout) Unwrap() error {
+	return e.Err
+}
+
+// ErrAutoRenewPeriodOutOfRange is returned when freezing an AccountCreateTransaction whose auto renew period
+// is outside of its auto renew period bounds.
+type ErrAutoRenewPeriodOutOfRange struct {
+	AutoRenewPeriod time.Duration
+	Min             time.Duration
+	Max             time.Duration
+}
+
+// Error() implements the Error interface
+func (e ErrAutoRenewPeriodOutOfRange) Error() string {
+	return fmt.Sprintf("auto renew period %s is not in range [%s, %s]", e.AutoRenewPeriod, e.Min, e.Max)
+}
+
+// ErrDuplicateNftTransfer is returned when freezing a TransferTransaction which transfers the same NFT more than once.
+type ErrDuplicateNftTransfer struct {
+	NftID NftID
+}
+
+// Error() implements the Error interface
+func (e ErrDuplicateNftTransfer) Error() string {
+	return fmt.Sprintf("NFT %s is transferred more than once", e.NftID.String())
+}
+
+// ErrNftTransferToSelf is returned when freezing a TransferTransaction which transfers an NFT from an account
+// to the same account.
+type ErrNftTransferToSelf struct {
+	NftID     NftID
+	AccountID AccountID
+}
+
+// Error() implements the Error interface
+func (e ErrNftTransferToSelf) Error() string {
+	return fmt.Sprintf("NFT %s is transferred from and to the same account %s", e.NftID.String(), e.AccountID.String())
+}
+
+// ErrInvalidDerivationPath is returned when a BIP-32 derivation path is malformed or cannot be used to derive
+// the requested key type.
+type ErrInvalidDerivationPath struct {
+	Path   string
+	Reason string
+}
+
+// Error() implements the Error interface
+func (e ErrInvalidDerivationPath) Error() string {
+	return fmt.Sprintf("invalid derivation path `%s`: %s", e.Path, e.Reason)
+}
+
+// ErrInvalidHbarAmount is returned when a decimal hbar amount string cannot be converted to tinybar exactly.
+type ErrInvalidHbarAmount struct {
+	Amount string
+	Reason string
+}
+
+// Error() implements the Error interface
+func (e ErrInvalidHbarAmount) Error() string {
+	return fmt.Sprintf("invalid hbar amount `%s`: %s", e.Amount, e.Reason)
+}
+
+// ErrTooManyTransfers is reported when a TransferTransaction has more hbar or token transfers than the
+// network accepts in one transaction.
+type ErrTooManyTransfers struct {
+	Kind     string
+	Count    int
+	MaxCount int
+}
+
+// Error() implements the Error interface
+func (e ErrTooManyTransfers) Error() string {
+	return fmt.Sprintf("%d %s transfers exceed the maximum of %d", e.Count, e.Kind, e.MaxCount)
+}
+
+// ErrInsufficientPayerBalance is reported when the payer's balance cannot cover its hbar debit and the max
+// transaction fee.
+type ErrInsufficientPayerBalance struct {
+	AccountID AccountID
+	Balance   Hbar
+	Required  Hbar
+}
+
+// Error() implements the Error interface
+func (e ErrInsufficientPayerBalance) Error() string {
+	return fmt.Sprintf("payer %s has a balance of %s, but needs %s", e.AccountID.String(), e.Balance.String(), e.Required.String())
+}
+
+// ErrHbarTransfersUnbalanced is returned when freezing a TransferTransaction which validates its transfers and
+// the hbar transfers do not sum to zero. Imbalance is the net amount, positive if more is credited than debited.
+type ErrHbarTransfersUnbalanced struct {
+	Imbalance Hbar
+}
+
+// Error() implements the Error interface
+func (e ErrHbarTransfersUnbalanced) Error() string {
+	return fmt.Sprintf("hbar transfers must sum to zero, but they net to %d tinybar", e.Imbalance.AsTinybar())
+}
+
+// ErrTokenDecimalsMismatch is returned when freezing a TransferTransaction which adds transfers of the same token
+// with different expected decimals.
+type ErrTokenDecimalsMismatch struct {
+	TokenID          TokenID
+	ExpectedDecimals uint32
+	Decimals         uint32
+}
+
+// Error() implements the Error interface
+func (e ErrTokenDecimalsMismatch) Error() string {
+	return fmt.Sprintf("token %s is transferred with %d decimals, but earlier transfers expect %d", e.TokenID.String(), e.Decimals, e.ExpectedDecimals)
+}
+
+// ErrTransactionNotSchedulable is returned when scheduling a transaction whose IsSchedulable returns false.
+type ErrTransactionNotSchedulable struct {
+	Transaction string
+}
+
+// Error() implements the Error interface
+func (e ErrTransactionNotSchedulable) Error() string {
+	return fmt.Sprintf("cannot schedule `%s`", e.Transaction)
+}
+
+// ErrMemoTooLong is returned when freezing a transaction whose memo is longer than the client's
+// max transaction memo bytes.
+type ErrMemoTooLong struct {
+	Length    int
+	MaxLength int
+}
+
+// Error() implements the Error interface
+func (e ErrMemoTooLong) Error() string {
+	return fmt.Sprintf("transaction memo is %d bytes, but at most %d bytes are allowed", e.Length, e.MaxLength)
+}
+
+// ErrScheduleExpirationOutOfRange is returned when freezing a ScheduleCreateTransaction whose expiration time is
+// not after the transaction's valid start, or is further past it than the network allows.
+type ErrScheduleExpirationOutOfRange struct {
+	ExpirationTime time.Time
+	ValidStart     time.Time
+	MaxLifetime    time.Duration
+}
+
+// Error() implements the Error interface
+func (e ErrScheduleExpirationOutOfRange) Error() string {
+	return fmt.Sprintf("schedule expiration time %s must be after the transaction valid start %s and at most %s later",
+		e.ExpirationTime.UTC().Format(time.RFC3339), e.ValidStart.UTC().Format(time.RFC3339), e.MaxLifetime)
+}
+
+// ErrInsufficientBalanceForFee is returned by NewSweepHbarTransaction when the account's balance
+// does not cover the estimated transaction fee.
+type ErrInsufficientBalanceForFee struct {
+	Balance Hbar
+	Fee     Hbar
+}
+
+// Error() implements the Error interface
+func (e ErrInsufficientBalanceForFee) Error() string {
+	return fmt.Sprintf("balance %s does not exceed the estimated fee %s", e.Balance.String(), e.Fee.String())
+}
+
+// ErrSignerFailed is returned when a Signer could not produce a signature for a transaction.
+type ErrSignerFailed struct {
+	PublicKey PublicKey
+	Err       error
+}
+
+// Error() implements the Error interface
+func (e ErrSignerFailed) Error() string {
+	return fmt.Sprintf("signer for key %s failed: %s", e.PublicKey.String(), e.Err)
+}
+
+// Unwrap returns the error reported by the signer
+func (e ErrSignerFailed) Unwrap() error {
+	return e.Err
+}
+
+// ErrSignaturesMissing is returned when the signatures attached to a transaction do not satisfy a key.
+// Remaining is how many more signatures are needed and MissingKeys lists the keys which could provide them.
+type ErrSignaturesMissing struct {
+	Remaining   int
+	MissingKeys []PublicKey
+}
+
+// Error() implements the Error interface
+func (e ErrSignaturesMissing) Error() string {
+	missing := make([]string, len(e.MissingKeys))
+	for i, key := range e.MissingKeys {
+		missing[i] = key.String()
+	}
+
+	return fmt.Sprintf("%d more signature(s) required from keys [%s]", e.Remaining, strings.Join(missing, ", "))
+}
+
+// ErrDuplicateTransactionID is returned by Execute when the client already submitted a transaction with the
+// same ID which is still valid, and the client is set to fail on duplicates.
+type ErrDuplicateTransactionID struct {
+	TransactionID TransactionID
+}
+
+// Error() implements the Error interface
+func (e ErrDuplicateTransactionID) Error() string {
+	return fmt.Sprintf("transaction ID %s was already submitted and is still valid", e.TransactionID.String())
+}
+
+// ErrMnemonicInvalidLength is returned when a mnemonic does not have 12, 22 or 24 words.
+type ErrMnemonicInvalidLength struct {
+	WordCount int
+}
+
+// Error() implements the Error interface
+func (e ErrMnemonicInvalidLength) Error() string {
+	return fmt.Sprintf("mnemonic has %d words, but must have 12, 22 or 24", e.WordCount)
+}
+
+// ErrMnemonicUnknownWords is returned when words of a mnemonic are not in its word list. Indices holds the
+// zero-based position of each unknown word in Words.
+type ErrMnemonicUnknownWords struct {
+	Indices []int
+	Words   []string
+}
+
+// Error() implements the Error interface
+func (e ErrMnemonicUnknownWords) Error() string {
+	unknown := make([]string, len(e.Indices))
+	for i, index := range e.Indices {
+		unknown[i] = fmt.Sprintf("`%s` at position %d", e.Words[i], index)
+	}
+
+	return fmt.Sprintf("mnemonic words are not in the word list: %s", strings.Join(unknown, ", "))
+}
+
+// ErrTemplatePlaceholderUnset is returned when building a transaction from a template whose placeholder
+// has not been filled in.
+type ErrTemplatePlaceholderUnset struct {
+	Placeholder string
+}
+
+// Error() implements the Error interface
+func (e ErrTemplatePlaceholderUnset) Error() string {
+	return fmt.Sprintf("template placeholder `%s` is not set", e.Placeholder)
+}
+
+// ErrTokenDecimalsIncorrect is returned when freezing a TransferTransaction which verifies token decimals and
+// the decimals its transfers expect are not the token's decimals.
+type ErrTokenDecimalsIncorrect struct {
+	TokenID          TokenID
+	ExpectedDecimals uint32
+	Decimals         uint32
+}
+
+// Error() implements the Error interface
+func (e ErrTokenDecimalsIncorrect) Error() string {
+	return fmt.Sprintf("token %s is transferred expecting %d decimals, but the token has %d decimals", e.TokenID.String(), e.ExpectedDecimals, e.Decimals)
+}
+
+// ErrTransactionBodiesInconsistent is returned by Transaction.VerifyBodiesConsistent when the body sent to a node
+// differs from the body sent to the first node in anything other than the node account ID.
+type ErrTransactionBodiesInconsistent struct {
+	NodeAccountID      AccountID
+	FirstNodeAccountID AccountID
+}
+
+// Error() implements the Error interface
+func (e ErrTransactionBodiesInconsistent) Error() string {
+	return fmt.Sprintf("transaction body for node %s differs from the body for node %s in more than the node account ID",
+		e.NodeAccountID.String(), e.FirstNodeAccountID.String())
+}
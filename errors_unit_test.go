@@ -0,0 +1,202 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnitErrorsIsNoClientProvided(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTransferTransaction().Execute(nil)
+	require.True(t, errors.Is(err, ErrNoClientProvided))
+
+	_, err = NewAccountBalanceQuery().SetAccountID(AccountID{Account: 3}).Execute(nil)
+	require.True(t, errors.Is(err, ErrNoClientProvided))
+}
+
+func TestUnitErrorsIsNoOperator(t *testing.T) {
+	t.Parallel()
+
+	// The server has no responses, so any request reaching it would fail with a different error
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+	client.operator = nil
+
+	_, err := NewAccountInfoQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 5}).
+		Execute(client)
+	require.ErrorIs(t, err, ErrNoOperator)
+	require.ErrorIs(t, err, ErrNoClientProvided)
+
+	_, err = NewAccountInfoQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 5}).
+		GetCost(client)
+	require.ErrorIs(t, err, ErrNoOperator)
+
+	_, err = NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		Execute(client)
+	require.ErrorIs(t, err, ErrNoOperator)
+}
+
+func TestUnitErrorsAsFreezeFailed(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		FreezeWith(nil)
+	require.Error(t, err)
+
+	var freezeErr ErrFreezeFailed
+	require.True(t, errors.As(err, &freezeErr))
+	assert.True(t, errors.Is(err, ErrNoClientOrTransactionID))
+
+	_, err = NewFileAppendTransaction().FreezeWith(nil)
+	require.True(t, errors.As(err, &freezeErr))
+	assert.True(t, errors.Is(err, ErrNoClientOrTransactionIDOrNodeID))
+
+	_, err = NewTopicMessageSubmitTransaction().FreezeWith(nil)
+	require.True(t, errors.As(err, &freezeErr))
+	assert.True(t, errors.Is(err, ErrNoClientOrTransactionIDOrNodeID))
+}
+
+func TestUnitErrorsIsPreCheckStatus(t *testing.T) {
+	t.Parallel()
+
+	err := error(ErrHederaPreCheckStatus{
+		TxID:   TransactionIDGenerate(AccountID{Account: 5}),
+		Status: StatusInsufficientPayerBalance,
+	})
+
+	assert.True(t, errors.Is(err, ErrHederaPreCheckStatus{Status: StatusInsufficientPayerBalance}))
+	assert.True(t, errors.Is(err, ErrHederaPreCheckStatus{
+		TxID:   TransactionIDGenerate(AccountID{Account: 6}),
+		Status: StatusInsufficientPayerBalance,
+	}))
+	assert.False(t, errors.Is(err, ErrHederaPreCheckStatus{Status: StatusBusy}))
+	assert.False(t, errors.Is(err, ErrHederaReceiptStatus{Status: StatusInsufficientPayerBalance}))
+
+	assert.True(t, errors.Is(
+		ErrHederaReceiptStatus{Status: StatusInvalidSignature, Receipt: TransactionReceipt{}},
+		ErrHederaReceiptStatus{Status: StatusInvalidSignature},
+	))
+}
+
+func TestUnitErrorsAsMaxAttemptsExceededTransaction(t *testing.T) {
+	t.Parallel()
+
+	busy := &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY}
+	responses := [][]interface{}{{busy, busy}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+	client.SetMaxAttempts(2)
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.Error(t, err)
+
+	precheckErr, ok := err.(ErrHederaPreCheckStatus)
+	require.True(t, ok)
+	assert.Equal(t, StatusBusy, precheckErr.Status)
+
+	var attemptsErr ErrMaxAttemptsExceeded
+	require.True(t, errors.As(err, &attemptsErr))
+	assert.Equal(t, 2, attemptsErr.Attempts)
+	assert.Equal(t, 2, attemptsErr.MaxAttempts)
+	assert.Equal(t, "retry 2/2", attemptsErr.Error())
+	assert.True(t, errors.Is(err, ErrHederaPreCheckStatus{Status: StatusBusy}))
+}
+
+func TestUnitErrorsAsMaxAttemptsExceededQuery(t *testing.T) {
+	t.Parallel()
+
+	busy := &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY, ResponseType: services.ResponseType_ANSWER_ONLY},
+			},
+		},
+	}
+	responses := [][]interface{}{{busy, busy}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+	client.SetMaxAttempts(2)
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.Error(t, err)
+
+	_, ok := err.(ErrHederaPreCheckStatus)
+	require.True(t, ok)
+
+	var attemptsErr ErrMaxAttemptsExceeded
+	require.True(t, errors.As(err, &attemptsErr))
+	assert.Equal(t, 2, attemptsErr.Attempts)
+	assert.True(t, errors.Is(err, ErrHederaPreCheckStatus{Status: StatusBusy}))
+}
+
+func TestUnitErrorsAsHederaNetwork(t *testing.T) {
+	t.Parallel()
+
+	grpcErr := status.New(codes.InvalidArgument, "bad request").Err()
+	responses := [][]interface{}{{grpcErr}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.Error(t, err)
+
+	var networkErr ErrHederaNetwork
+	require.True(t, errors.As(err, &networkErr))
+	require.NotNil(t, networkErr.StatusCode)
+	assert.Equal(t, codes.InvalidArgument, *networkErr.StatusCode)
+	assert.Contains(t, err.Error(), "retry 0/10")
+	assert.Equal(t, codes.InvalidArgument, status.Code(errors.Unwrap(networkErr)))
+}
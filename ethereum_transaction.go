@@ -155,6 +155,19 @@ func (tx *EthereumTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *EthereumTransaction) SignWithSigner(signer Signer) *EthereumTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this EthereumTransaction.
+func (tx *EthereumTransaction) SetSigningKeys(keys []PublicKey) *EthereumTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *EthereumTransaction) AddSignature(publicKey PublicKey, signature []byte) *EthereumTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -251,6 +264,12 @@ func (tx *EthereumTransaction) SetMaxBackoff(max time.Duration) *EthereumTransac
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *EthereumTransaction) SetSingleNode(singleNode bool) *EthereumTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *EthereumTransaction) SetMinBackoff(min time.Duration) *EthereumTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -314,6 +333,11 @@ func (tx *EthereumTransaction) buildScheduled() (*services.SchedulableTransactio
 	return nil, errors.New("cannot schedule `EthereumTransaction")
 }
 
+// IsSchedulable returns false, as a EthereumTransaction can't be scheduled.
+func (tx *EthereumTransaction) IsSchedulable() bool {
+	return false
+}
+
 func (tx *EthereumTransaction) getMethod(channel *_Channel) _Method {
 	return _Method{
 		transaction: channel._GetContract().CallEthereum,
@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	protobuf "google.golang.org/protobuf/proto"
@@ -50,6 +51,25 @@ func _ExchangeRateFromProtobuf(protoExchange *services.ExchangeRate) ExchangeRat
 	}
 }
 
+// GetHbars returns the hbar-equivalent side of the rate: Hbars hbar are worth GetCents() US cents.
+func (exchange *ExchangeRate) GetHbars() int32 {
+	return exchange.Hbars
+}
+
+// GetCents returns the cent-equivalent side of the rate: GetHbars() hbar are worth this many US cents.
+func (exchange *ExchangeRate) GetCents() int32 {
+	return exchange.cents
+}
+
+// GetExpirationTime returns the time after which the rate is no longer in effect, or the zero time if unset.
+func (exchange *ExchangeRate) GetExpirationTime() time.Time {
+	if exchange.expirationTime == nil {
+		return time.Time{}
+	}
+
+	return time.Unix(exchange.expirationTime.Seconds, 0)
+}
+
 func (exchange *ExchangeRate) _ToProtobuf() *services.ExchangeRate {
 	return &services.ExchangeRate{
 		HbarEquiv:      exchange.Hbars,
@@ -71,7 +91,7 @@ func (exchange *ExchangeRate) ToBytes() []byte {
 // ExchangeRateFromString returns an ExchangeRate from a string representation of the exchange rate
 func ExchangeRateFromBytes(data []byte) (ExchangeRate, error) {
 	if data == nil {
-		return ExchangeRate{}, errByteArrayNull
+		return ExchangeRate{}, ErrByteArrayNull
 	}
 	pb := services.ExchangeRate{}
 	err := protobuf.Unmarshal(data, &pb)
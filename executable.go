@@ -56,9 +56,10 @@ type Executable interface {
 	GetMaxRetry() int
 	GetNodeAccountIDs() []AccountID
 	GetLogLevel() *LogLevel
+	GetSingleNode() bool
 
 	shouldRetry(Executable, interface{}) _ExecutionState
-	makeRequest() interface{}
+	makeRequest() (interface{}, error)
 	advanceRequest()
 	getNodeAccountID() AccountID
 	getMethod(*_Channel) _Method
@@ -70,6 +71,8 @@ type Executable interface {
 	getLogger(Logger) Logger
 	getTransactionIDAndMessage() (string, string)
 	getLogID(Executable) string // This returns transaction creation timestamp + transaction name
+	getBackoff(*Client) (time.Duration, time.Duration, error)
+	getAttemptLimit() int
 }
 
 type executable struct {
@@ -80,6 +83,9 @@ type executable struct {
 	grpcDeadline   *time.Duration
 	maxRetry       int
 	logLevel       *LogLevel
+	singleNode     bool
+	// attemptLimit caps the attempts even below Client.SetMaxAttempts; 0 means no cap.
+	attemptLimit int
 }
 
 type _Method struct {
@@ -95,6 +101,41 @@ type _Method struct {
 	) (*services.TransactionResponse, error)
 }
 
+// _RequestInterceptor answers a request in place of the node it was sent to. requestType is the name of the
+// transaction or query and request is its *services.Transaction or *services.Query.
+type _RequestInterceptor func(requestType string, request interface{}) (interface{}, error)
+
+// _InterceptedMethod routes a transaction or query through interceptor instead of a gRPC channel.
+func _InterceptedMethod(interceptor _RequestInterceptor, requestType string, isTransaction bool) _Method {
+	if isTransaction {
+		return _Method{
+			transaction: func(_ context.Context, request *services.Transaction, _ ...grpc.CallOption) (*services.TransactionResponse, error) {
+				resp, err := interceptor(requestType, request)
+				if err != nil {
+					return nil, err
+				}
+				if response, ok := resp.(*services.TransactionResponse); ok {
+					return response, nil
+				}
+				return nil, errors.Errorf("request interceptor returned %T for %s, expected *services.TransactionResponse", resp, requestType)
+			},
+		}
+	}
+
+	return _Method{
+		query: func(_ context.Context, request *services.Query, _ ...grpc.CallOption) (*services.Response, error) {
+			resp, err := interceptor(requestType, request)
+			if err != nil {
+				return nil, err
+			}
+			if response, ok := resp.(*services.Response); ok {
+				return response, nil
+			}
+			return nil, errors.Errorf("request interceptor returned %T for %s, expected *services.Response", resp, requestType)
+		},
+	}
+}
+
 func (e *executable) GetMaxBackoff() time.Duration {
 	if e.maxBackoff != nil {
 		return *e.maxBackoff
@@ -114,7 +155,7 @@ func (e *executable) GetMinBackoff() time.Duration {
 func (e *executable) SetMaxBackoff(max time.Duration) *executable {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
-	} else if max.Nanoseconds() < e.minBackoff.Nanoseconds() {
+	} else if max.Nanoseconds() < e.GetMinBackoff().Nanoseconds() {
 		panic("maxBackoff must be greater than or equal to minBackoff")
 	}
 	e.maxBackoff = &max
@@ -124,13 +165,30 @@ func (e *executable) SetMaxBackoff(max time.Duration) *executable {
 func (e *executable) SetMinBackoff(min time.Duration) *executable {
 	if min.Nanoseconds() < 0 {
 		panic("minBackoff must be a positive duration")
-	} else if e.maxBackoff.Nanoseconds() < min.Nanoseconds() {
+	} else if e.GetMaxBackoff().Nanoseconds() < min.Nanoseconds() {
 		panic("minBackoff must be less than or equal to maxBackoff")
 	}
 	e.minBackoff = &min
 	return e
 }
 
+// getBackoff returns the minimum and maximum delay between attempts. A backoff set on the request takes
+// precedence over the client's, so the pair can mix both; it fails if that leaves max below min.
+func (e *executable) getBackoff(client *Client) (time.Duration, time.Duration, error) {
+	min, max := client.GetMinBackoff(), client.GetMaxBackoff()
+	if e.minBackoff != nil {
+		min = *e.minBackoff
+	}
+	if e.maxBackoff != nil {
+		max = *e.maxBackoff
+	}
+	if max < min {
+		return 0, 0, errors.Errorf("maxBackoff %s must be greater than or equal to minBackoff %s", max, min)
+	}
+
+	return min, max, nil
+}
+
 // GetGrpcDeadline returns the grpc deadline
 func (e *executable) GetGrpcDeadline() *time.Duration {
 	return e.grpcDeadline
@@ -147,11 +205,30 @@ func (e *executable) GetMaxRetry() int {
 	return e.maxRetry
 }
 
+// SetMaxRetry sets the max number of attempts. Client.SetMaxAttempts takes precedence over it when set.
 func (e *executable) SetMaxRetry(max int) *executable {
 	e.maxRetry = max
 	return e
 }
 
+func (e *executable) getAttemptLimit() int {
+	return e.attemptLimit
+}
+
+// GetSingleNode returns whether every attempt stays on the same node.
+func (e *executable) GetSingleNode() bool {
+	return e.singleNode
+}
+
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+// Retryable responses such as BUSY are still retried with backoff, but on that node, and other
+// errors from it are returned directly. Only the first node account ID is used; when none are set,
+// a single node is picked by the client.
+func (e *executable) SetSingleNode(singleNode bool) *executable {
+	e.singleNode = singleNode
+	return e
+}
+
 // GetNodeAccountID returns the node AccountID for this transaction.
 func (e *executable) GetNodeAccountIDs() []AccountID {
 	nodeAccountIDs := []AccountID{}
@@ -192,19 +269,32 @@ func (e *executable) getNodeAccountID() AccountID {
 }
 
 func _Execute(client *Client, e Executable) (interface{}, error) {
+	return _ExecuteWithContext(context.Background(), client, e)
+}
+
+// _ExecuteWithContext runs the retry loop of _Execute, stopping with ctx.Err() as soon as ctx is done,
+// including while waiting between attempts.
+func _ExecuteWithContext(ctx context.Context, client *Client, e Executable) (interface{}, error) {
 	var maxAttempts int
+	minBackoff, maxBackoff, err := e.getBackoff(client)
+	if err != nil {
+		return _ExecutableEmptyResponse(e), err
+	}
 	backOff := backoff.NewExponentialBackOff()
-	backOff.InitialInterval = e.GetMinBackoff()
-	backOff.MaxInterval = e.GetMaxBackoff()
+	backOff.InitialInterval = minBackoff
+	backOff.MaxInterval = maxBackoff
 	backOff.Multiplier = 2
+	backOff.MaxElapsedTime = 0
+	backOff.Reset()
 
 	if client.maxAttempts != nil {
 		maxAttempts = *client.maxAttempts
 	} else {
 		maxAttempts = e.GetMaxRetry()
 	}
-
-	currentBackoff := e.GetMinBackoff()
+	if limit := e.getAttemptLimit(); limit > 0 && limit < maxAttempts {
+		maxAttempts = limit
+	}
 
 	var attempt int64
 	var errPersistent error
@@ -213,18 +303,27 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 	txLogger := e.getLogger(client.logger)
 	txID, msg := e.getTransactionIDAndMessage()
 
-	for attempt = int64(0); attempt < int64(maxAttempts); attempt, currentBackoff = attempt+1, currentBackoff*2 {
+	for attempt = int64(0); attempt < int64(maxAttempts); attempt++ {
 		var protoRequest interface{}
 		var node *_Node
 		var ok bool
 
+		if err := ctx.Err(); err != nil {
+			return _ExecutableEmptyResponse(e), err
+		}
+
 		if e.isTransaction() {
-			if attempt > 0 && len(e.GetNodeAccountIDs()) > 1 {
+			if attempt > 0 && len(e.GetNodeAccountIDs()) > 1 && !e.GetSingleNode() {
 				e.advanceRequest()
 			}
 		}
 
-		protoRequest = e.makeRequest()
+		// A request which can't be built, e.g. because a signer failed, fails the same way on every attempt
+		var err error
+		protoRequest, err = e.makeRequest()
+		if err != nil {
+			return _ExecutableEmptyResponse(e), err
+		}
 		if len(e.GetNodeAccountIDs()) == 0 {
 			node = client.network._GetNode()
 		} else {
@@ -246,41 +345,50 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 
 		if !node._IsHealthy() {
 			txLogger.Trace("node is unhealthy, waiting before continuing", "requestId", e.getLogID(e), "delay", node._Wait().String())
-			_DelayForAttempt(e.getLogID(e), currentBackoff, attempt, txLogger)
+			if err := _DelayForAttempt(ctx, e.getLogID(e), _JitteredBackoff(backOff, minBackoff, maxBackoff), attempt, txLogger); err != nil {
+				return _ExecutableEmptyResponse(e), err
+			}
 			continue
 		}
 
 		txLogger.Trace("updating node account ID index", "requestId", e.getLogID(e))
-		channel, err := node._GetChannel(txLogger)
-		if err != nil {
-			client.network._IncreaseBackoff(node)
-			continue
+		var method _Method
+		if client.requestInterceptor != nil {
+			method = _InterceptedMethod(client.requestInterceptor, e.getName(), e.isTransaction())
+		} else {
+			var channel *_Channel
+			channel, err = node._GetChannel(txLogger)
+			if err != nil {
+				client.network._IncreaseBackoff(node)
+				continue
+			}
+			method = e.getMethod(channel)
 		}
 
-		e.advanceRequest()
-
-		method := e.getMethod(channel)
+		if !e.GetSingleNode() {
+			e.advanceRequest()
+		}
 
 		var resp interface{}
 
-		ctx := context.TODO()
+		callCtx := ctx
 		var cancel context.CancelFunc
 
 		if e.GetGrpcDeadline() != nil {
 			grpcDeadline := time.Now().Add(*e.GetGrpcDeadline())
-			ctx, cancel = context.WithDeadline(ctx, grpcDeadline)
+			callCtx, cancel = context.WithDeadline(ctx, grpcDeadline)
 		}
 
 		txLogger.Trace("executing gRPC call", "requestId", e.getLogID(e))
 
 		var marshaledResponse []byte
 		if method.query != nil {
-			resp, err = method.query(ctx, protoRequest.(*services.Query))
+			resp, err = method.query(callCtx, protoRequest.(*services.Query))
 			if err == nil {
 				marshaledResponse, _ = protobuf.Marshal(resp.(*services.Response))
 			}
 		} else {
-			resp, err = method.transaction(ctx, protoRequest.(*services.Transaction))
+			resp, err = method.transaction(callCtx, protoRequest.(*services.Transaction))
 			if err == nil {
 				marshaledResponse, _ = protobuf.Marshal(resp.(*services.TransactionResponse))
 			}
@@ -290,8 +398,11 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 			cancel()
 		}
 		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return _ExecutableEmptyResponse(e), ctxErr
+			}
 			errPersistent = err
-			if _ExecutableDefaultRetryHandler(e.getLogID(e), err, txLogger) {
+			if client._IsNodeUnhealthyError(e.getLogID(e), err, txLogger) {
 				client.network._IncreaseBackoff(node)
 				continue
 			}
@@ -299,11 +410,14 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 				errPersistent = errors.New("error")
 			}
 
+			code := status.Code(errPersistent)
+			errPersistent = errors.Wrapf(ErrHederaNetwork{error: errPersistent, StatusCode: &code}, "retry %d/%d", attempt, maxAttempts)
+
 			if e.isTransaction() {
-				return TransactionResponse{}, errors.Wrapf(errPersistent, "retry %d/%d", attempt, maxAttempts)
+				return TransactionResponse{}, errPersistent
 			}
 
-			return &services.Response{}, errors.Wrapf(errPersistent, "retry %d/%d", attempt, maxAttempts)
+			return &services.Response{}, errPersistent
 		}
 
 		node._DecreaseBackoff()
@@ -324,7 +438,9 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 		switch e.shouldRetry(e, resp) {
 		case executionStateRetry:
 			errPersistent = statusError
-			_DelayForAttempt(e.getLogID(e), currentBackoff, attempt, txLogger)
+			if err := _DelayForAttempt(ctx, e.getLogID(e), _JitteredBackoff(backOff, minBackoff, maxBackoff), attempt, txLogger); err != nil {
+				return _ExecutableEmptyResponse(e), err
+			}
 			continue
 		case executionStateExpired:
 			if e.isTransaction() {
@@ -354,23 +470,80 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 		errPersistent = errors.New("error")
 	}
 
+	// A precheck status is returned as is, so it can still be type asserted, with the attempts behind Unwrap.
+	if precheckErr, ok := errPersistent.(ErrHederaPreCheckStatus); ok {
+		precheckErr.attempts = ErrMaxAttemptsExceeded{Attempts: int(attempt), MaxAttempts: maxAttempts}
+		errPersistent = precheckErr
+	}
+
 	if e.isTransaction() {
+		if _, ok := errPersistent.(ErrHederaPreCheckStatus); ok {
+			return TransactionResponse{}, errPersistent
+		}
+
 		return TransactionResponse{}, errors.Wrapf(errPersistent, "retry %d/%d", attempt, maxAttempts)
 	}
 
 	return &services.Response{}, errPersistent
 }
 
-func _DelayForAttempt(logID string, backoff time.Duration, attempt int64, logger Logger) {
+// _JitteredBackoff returns the next delay of backOff, which doubles on every call and is randomized by up to half
+// either way, kept within [min, max].
+func _JitteredBackoff(backOff *backoff.ExponentialBackOff, min time.Duration, max time.Duration) time.Duration {
+	delay := backOff.NextBackOff()
+	if delay < min {
+		return min
+	}
+	if delay > max {
+		return max
+	}
+
+	return delay
+}
+
+// _DelayForAttempt waits out the backoff before the next attempt, returning ctx.Err() early if ctx is done first.
+func _DelayForAttempt(ctx context.Context, logID string, backoff time.Duration, attempt int64, logger Logger) error {
 	logger.Trace("retrying request attempt", "requestId", logID, "delay", backoff, "attempt", attempt+1)
 
-	time.Sleep(backoff)
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func _ExecutableEmptyResponse(e Executable) interface{} {
+	if e.isTransaction() {
+		return TransactionResponse{}
+	}
+
+	return &services.Response{}
 }
 
 func _ExecutableDefaultRetryHandler(logID string, err error, logger Logger) bool {
-	code := status.Code(err)
-	logger.Trace("received gRPC error with status code", "requestId", logID, "status", code.String())
-	switch code {
+	logger.Trace("received gRPC error with status code", "requestId", logID, "status", status.Code(err).String())
+	return DefaultNodeUnhealthyClassifier(err)
+}
+
+// _IsNodeUnhealthyError reports whether err from a gRPC call marks the node unhealthy.
+func (client *Client) _IsNodeUnhealthyError(logID string, err error, logger Logger) bool {
+	if client.nodeUnhealthyClassifier == nil {
+		return _ExecutableDefaultRetryHandler(logID, err, logger)
+	}
+
+	logger.Trace("received gRPC error with status code", "requestId", logID, "status", status.Code(err).String())
+	return client.nodeUnhealthyClassifier(err)
+}
+
+// DefaultNodeUnhealthyClassifier is the classifier Client.SetNodeUnhealthyClassifier defaults to. It marks a node
+// unhealthy when the gRPC call failed with RESOURCE_EXHAUSTED or UNAVAILABLE, or with INTERNAL because the node
+// reset the stream.
+func DefaultNodeUnhealthyClassifier(err error) bool {
+	switch status.Code(err) {
 	case codes.ResourceExhausted, codes.Unavailable:
 		return true
 	case codes.Internal:
@@ -44,7 +44,7 @@ type FeeComponents struct {
 
 func _FeeComponentsFromProtobuf(feeComponents *services.FeeComponents) (FeeComponents, error) {
 	if feeComponents == nil {
-		return FeeComponents{}, errParameterNull
+		return FeeComponents{}, ErrParameterNull
 	}
 
 	return FeeComponents{
@@ -91,7 +91,7 @@ func (feeComponents FeeComponents) ToBytes() []byte {
 // FeeComponentsFromBytes returns the FeeComponents from a byte array representation
 func FeeComponentsFromBytes(data []byte) (FeeComponents, error) {
 	if data == nil {
-		return FeeComponents{}, errByteArrayNull
+		return FeeComponents{}, ErrByteArrayNull
 	}
 	pb := services.FeeComponents{}
 	err := protobuf.Unmarshal(data, &pb)
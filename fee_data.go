@@ -35,7 +35,7 @@ type FeeData struct {
 
 func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
 	if feeData == nil {
-		return FeeData{}, errParameterNull
+		return FeeData{}, ErrParameterNull
 	}
 
 	nodeData, err := _FeeComponentsFromProtobuf(feeData.Nodedata)
@@ -96,7 +96,7 @@ func (feeData FeeData) ToBytes() []byte {
 // FeeDataFromBytes returns a FeeData struct from a raw byte array
 func FeeDataFromBytes(data []byte) (FeeData, error) {
 	if data == nil {
-		return FeeData{}, errByteArrayNull
+		return FeeData{}, ErrByteArrayNull
 	}
 	pb := services.FeeData{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -35,7 +35,7 @@ type FeeSchedule struct {
 
 func _FeeScheduleFromProtobuf(feeSchedule *services.FeeSchedule) (FeeSchedule, error) {
 	if feeSchedule == nil {
-		return FeeSchedule{}, errParameterNull
+		return FeeSchedule{}, ErrParameterNull
 	}
 
 	txFeeSchedules := make([]TransactionFeeSchedule, 0)
@@ -88,7 +88,7 @@ func (feeSchedule FeeSchedule) ToBytes() []byte {
 // FeeScheduleFromBytes returns a FeeSchedule from a raw protobuf byte array
 func FeeScheduleFromBytes(data []byte) (FeeSchedule, error) {
 	if data == nil {
-		return FeeSchedule{}, errByteArrayNull
+		return FeeSchedule{}, ErrByteArrayNull
 	}
 	pb := services.FeeSchedule{}
 	err := protobuf.Unmarshal(data, &pb)
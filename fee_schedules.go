@@ -34,7 +34,7 @@ type FeeSchedules struct {
 
 func _FeeSchedulesFromProtobuf(feeSchedules *services.CurrentAndNextFeeSchedule) (FeeSchedules, error) {
 	if feeSchedules == nil {
-		return FeeSchedules{}, errParameterNull
+		return FeeSchedules{}, ErrParameterNull
 	}
 
 	var current FeeSchedule
@@ -90,7 +90,7 @@ func (feeSchedules FeeSchedules) ToBytes() []byte {
 // FeeSchedulesFromBytes returns a FeeSchedules object from a raw byte array
 func FeeSchedulesFromBytes(data []byte) (FeeSchedules, error) {
 	if data == nil {
-		return FeeSchedules{}, errByteArrayNull
+		return FeeSchedules{}, ErrByteArrayNull
 	}
 	pb := services.CurrentAndNextFeeSchedule{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -148,6 +148,19 @@ func (tx *FileAppendTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *FileAppendTransaction) SignWithSigner(signer Signer) *FileAppendTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this FileAppendTransaction.
+func (tx *FileAppendTransaction) SetSigningKeys(keys []PublicKey) *FileAppendTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *FileAppendTransaction) AddSignature(publicKey PublicKey, signature []byte) *FileAppendTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -171,19 +184,20 @@ func (tx *FileAppendTransaction) FreezeWith(client *Client) (*FileAppendTransact
 
 	if tx.nodeAccountIDs._Length() == 0 {
 		if client == nil {
-			return tx, errNoClientOrTransactionIDOrNodeId
+			return tx, ErrFreezeFailed{Err: ErrNoClientOrTransactionIDOrNodeID}
 		}
 
-		tx.SetNodeAccountIDs(client.network._GetNodeAccountIDsForExecute())
+		tx.SetNodeAccountIDs(client._NodeAccountIDsForExecute())
 	}
 
-	tx._InitFee(client)
+	tx._InitFee(client, tx.getName())
+	tx._InitTransactionValidDuration(client)
 	err := tx.validateNetworkOnIDs(client)
 	if err != nil {
-		return &FileAppendTransaction{}, err
+		return &FileAppendTransaction{}, ErrFreezeFailed{Err: err}
 	}
 	if err := tx._InitTransactionID(client); err != nil {
-		return tx, err
+		return tx, ErrFreezeFailed{Err: err}
 	}
 	body := tx.build()
 
@@ -309,6 +323,12 @@ func (tx *FileAppendTransaction) SetMaxBackoff(max time.Duration) *FileAppendTra
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *FileAppendTransaction) SetSingleNode(singleNode bool) *FileAppendTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *FileAppendTransaction) SetMinBackoff(min time.Duration) *FileAppendTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -325,7 +345,7 @@ func (tx *FileAppendTransaction) Execute(
 	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
-		return TransactionResponse{}, errNoClientProvided
+		return TransactionResponse{}, ErrNoClientProvided
 	}
 
 	if tx.freezeError != nil {
@@ -355,8 +375,10 @@ func (tx *FileAppendTransaction) Execute(
 func (tx *FileAppendTransaction) ExecuteAll(
 	client *Client,
 ) ([]TransactionResponse, error) {
-	if client == nil || client.operator == nil {
-		return []TransactionResponse{}, errNoClientProvided
+	if client == nil {
+		return []TransactionResponse{}, ErrNoClientProvided
+	} else if client.operator == nil {
+		return []TransactionResponse{}, ErrNoOperator
 	}
 
 	if !tx.IsFrozen() {
@@ -373,11 +395,8 @@ func (tx *FileAppendTransaction) ExecuteAll(
 		return []TransactionResponse{}, errors.New("transactionID list is empty")
 	}
 
-	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		tx.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+	if err := tx._AutoSignWithOperator(client, *transactionID.AccountID); err != nil {
+		return []TransactionResponse{}, err
 	}
 
 	size := tx.signedTransactions._Length() / tx.nodeAccountIDs._Length()
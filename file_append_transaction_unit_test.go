@@ -280,7 +280,7 @@ func TestUnitFileAppendTransactionBigContentsMock(t *testing.T) {
 		}
 	}
 	responses := [][]interface{}{{
-		call, receipt, call, receipt, call, receipt, call, receipt, call, receipt, call, receipt, call,
+		call, receipt, call, receipt, call, receipt, call, receipt, call, receipt, call, receipt, call, receipt,
 	}}
 
 	client, server := NewMockClientAndServer(responses)
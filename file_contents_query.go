@@ -82,6 +82,19 @@ func (q *FileContentsQuery) SetMaxQueryPayment(maxPayment Hbar) *FileContentsQue
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *FileContentsQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *FileContentsQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *FileContentsQuery) SetRequestStateProof(request bool) *FileContentsQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *FileContentsQuery) SetQueryPayment(paymentAmount Hbar) *FileContentsQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -107,6 +120,12 @@ func (q *FileContentsQuery) SetMaxBackoff(max time.Duration) *FileContentsQuery
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *FileContentsQuery) SetSingleNode(singleNode bool) *FileContentsQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *FileContentsQuery) SetMinBackoff(min time.Duration) *FileContentsQuery {
 	q.Query.SetMinBackoff(min)
@@ -184,6 +184,19 @@ func (tx *FileCreateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *FileCreateTransaction) SignWithSigner(signer Signer) *FileCreateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this FileCreateTransaction.
+func (tx *FileCreateTransaction) SetSigningKeys(keys []PublicKey) *FileCreateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *FileCreateTransaction) AddSignature(publicKey PublicKey, signature []byte) *FileCreateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -205,7 +218,8 @@ func (tx *FileCreateTransaction) FreezeWith(client *Client) (*FileCreateTransact
 	if tx.IsFrozen() {
 		return tx, nil
 	}
-	tx._InitFee(client)
+	tx._InitFee(client, tx.getName())
+	tx._InitTransactionValidDuration(client)
 	if err := tx._InitTransactionID(client); err != nil {
 		return tx, err
 	}
@@ -279,6 +293,12 @@ func (tx *FileCreateTransaction) SetMaxBackoff(max time.Duration) *FileCreateTra
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *FileCreateTransaction) SetSingleNode(singleNode bool) *FileCreateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *FileCreateTransaction) SetMinBackoff(min time.Duration) *FileCreateTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -109,6 +109,19 @@ func (tx *FileDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *FileDeleteTransaction) SignWithSigner(signer Signer) *FileDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this FileDeleteTransaction.
+func (tx *FileDeleteTransaction) SetSigningKeys(keys []PublicKey) *FileDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *FileDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *FileDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -200,6 +213,12 @@ func (tx *FileDeleteTransaction) SetMaxBackoff(max time.Duration) *FileDeleteTra
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *FileDeleteTransaction) SetSingleNode(singleNode bool) *FileDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *FileDeleteTransaction) SetMinBackoff(min time.Duration) *FileDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
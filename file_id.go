@@ -81,7 +81,7 @@ func (id *FileID) ValidateChecksum(client *Client) error {
 			return err
 		}
 		if id.checksum == nil {
-			return errChecksumMissing
+			return ErrChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
 			networkName := NetworkNameOther
@@ -128,18 +128,12 @@ func (id FileID) String() string {
 
 // ToStringWithChecksum returns the string representation of a FileId with checksum.
 func (id FileID) ToStringWithChecksum(client Client) (string, error) {
-	if client.GetNetworkName() == nil && client.GetLedgerID() == nil {
-		return "", errNetworkNameMissing
-	}
-	var checksum _ParseAddressResult
-	var err error
-	if client.network.ledgerID != nil {
-		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.File))
-	}
+	checksum, err := _ChecksumForID(&client, id.Shard, id.Realm, id.File)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.File, checksum.correctChecksum), nil
+
+	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.File, checksum), nil
 }
 
 // ToSolidityAddress returns the string representation of a FileID in the format used by Solidity.
@@ -180,7 +174,7 @@ func (id FileID) ToBytes() []byte {
 // FileIDFromBytes returns a FileID from a byte array
 func FileIDFromBytes(data []byte) (FileID, error) {
 	if data == nil {
-		return FileID{}, errByteArrayNull
+		return FileID{}, ErrByteArrayNull
 	}
 	pb := services.FileID{}
 	err := protobuf.Unmarshal(data, &pb)
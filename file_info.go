@@ -40,7 +40,7 @@ type FileInfo struct {
 
 func _FileInfoFromProtobuf(fileInfo *services.FileGetInfoResponse_FileInfo) (FileInfo, error) {
 	if fileInfo == nil {
-		return FileInfo{}, errParameterNull
+		return FileInfo{}, ErrParameterNull
 	}
 	var keys KeyList
 	var err error
@@ -95,7 +95,7 @@ func (fileInfo FileInfo) ToBytes() []byte {
 // FileInfoFromBytes returns a FileInfo object from a raw byte array
 func FileInfoFromBytes(data []byte) (FileInfo, error) {
 	if data == nil {
-		return FileInfo{}, errByteArrayNull
+		return FileInfo{}, ErrByteArrayNull
 	}
 	pb := services.FileGetInfoResponse_FileInfo{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -91,6 +91,19 @@ func (q *FileInfoQuery) SetMaxQueryPayment(maxPayment Hbar) *FileInfoQuery {
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *FileInfoQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *FileInfoQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *FileInfoQuery) SetRequestStateProof(request bool) *FileInfoQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *FileInfoQuery) SetQueryPayment(paymentAmount Hbar) *FileInfoQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -116,6 +129,12 @@ func (q *FileInfoQuery) SetMaxBackoff(max time.Duration) *FileInfoQuery {
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *FileInfoQuery) SetSingleNode(singleNode bool) *FileInfoQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *FileInfoQuery) SetMinBackoff(min time.Duration) *FileInfoQuery {
 	q.Query.SetMinBackoff(min)
@@ -189,6 +189,19 @@ func (tx *FileUpdateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *FileUpdateTransaction) SignWithSigner(signer Signer) *FileUpdateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this FileUpdateTransaction.
+func (tx *FileUpdateTransaction) SetSigningKeys(keys []PublicKey) *FileUpdateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *FileUpdateTransaction) AddSignature(publicKey PublicKey, signature []byte) *FileUpdateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -275,6 +288,12 @@ func (tx *FileUpdateTransaction) SetMaxBackoff(max time.Duration) *FileUpdateTra
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *FileUpdateTransaction) SetSingleNode(singleNode bool) *FileUpdateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *FileUpdateTransaction) SetMinBackoff(min time.Duration) *FileUpdateTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -111,8 +111,9 @@ func TestUnitFileUpdateTransactionMock(t *testing.T) {
 			require.True(t, verified)
 		}
 
-		require.Equal(t, bytes.Compare(sigMap.SigPair[1].PubKeyPrefix, key.PublicKey().BytesRaw()), 0)
-		require.Equal(t, bytes.Compare(sigMap.SigPair[0].PubKeyPrefix, newKey.PublicKey().BytesRaw()), 0)
+		require.Len(t, sigMap.SigPair, 2)
+		require.ElementsMatch(t, [][]byte{key.PublicKey().BytesRaw(), newKey.PublicKey().BytesRaw()}, [][]byte{sigMap.SigPair[0].PubKeyPrefix, sigMap.SigPair[1].PubKeyPrefix})
+		require.Negative(t, bytes.Compare(sigMap.SigPair[0].PubKeyPrefix, sigMap.SigPair[1].PubKeyPrefix))
 
 		if bod, ok := transactionBody.Data.(*services.TransactionBody_FileUpdate); ok {
 			require.Equal(t, bod.FileUpdate.FileID.FileNum, int64(3))
@@ -152,6 +152,19 @@ func (tx *FreezeTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *FreezeTransaction) SignWithSigner(signer Signer) *FreezeTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this FreezeTransaction.
+func (tx *FreezeTransaction) SetSigningKeys(keys []PublicKey) *FreezeTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *FreezeTransaction) AddSignature(publicKey PublicKey, signature []byte) *FreezeTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -237,6 +250,12 @@ func (tx *FreezeTransaction) SetMaxBackoff(max time.Duration) *FreezeTransaction
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *FreezeTransaction) SetSingleNode(singleNode bool) *FreezeTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *FreezeTransaction) SetMinBackoff(min time.Duration) *FreezeTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -281,6 +300,11 @@ func (tx *FreezeTransaction) buildScheduled() (*services.SchedulableTransactionB
 		},
 	}, nil
 }
+
+// IsSchedulable returns false, as a FreezeTransaction can't be scheduled.
+func (tx *FreezeTransaction) IsSchedulable() bool {
+	return false
+}
 func (tx *FreezeTransaction) buildProtoBody() *services.FreezeTransactionBody {
 	body := &services.FreezeTransactionBody{
 		FileHash:   tx.fileHash,
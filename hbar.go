@@ -23,6 +23,7 @@ package hedera
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"regexp"
 	"strconv"
 
@@ -96,6 +97,26 @@ func HbarFromString(hbar string) (Hbar, error) {
 	return HbarFrom(a, _HbarUnitFromString(matchArray[2])), nil
 }
 
+// _HbarFromDecimalString parses a plain decimal hbar amount such as "1.25" exactly, without going through
+// float64. Amounts with more precision than a tinybar, or outside the range of Hbar, are rejected.
+func _HbarFromDecimalString(amount string) (Hbar, error) {
+	if !regexp.MustCompile(`^(?:\+|\-)?\d+(?:\.\d+)?$`).MatchString(amount) {
+		return Hbar{}, ErrInvalidHbarAmount{Amount: amount, Reason: "not a decimal number"}
+	}
+
+	value, _ := new(big.Rat).SetString(amount)
+	value.Mul(value, new(big.Rat).SetInt64(HbarUnits.Hbar._NumberOfTinybar()))
+	if !value.IsInt() {
+		return Hbar{}, ErrInvalidHbarAmount{Amount: amount, Reason: "more precise than one tinybar"}
+	}
+
+	if !value.Num().IsInt64() {
+		return Hbar{}, ErrInvalidHbarAmount{Amount: amount, Reason: "out of range"}
+	}
+
+	return HbarFromTinybar(value.Num().Int64()), nil
+}
+
 func _HbarUnitFromString(symbol string) HbarUnit {
 	switch symbol {
 	case HbarUnits.Tinybar.Symbol():
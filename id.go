@@ -185,6 +185,10 @@ func _IdFromString(s string) (shard int, realm int, num int, checksum *string, e
 }
 
 func _IdFromSolidityAddress(s string) (uint64, uint64, uint64, error) {
+	if _Has0xPrefix(s) {
+		s = s[2:]
+	}
+
 	bytes, err := hex.DecodeString(s)
 	if err != nil {
 		return 0, 0, 0, err
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -48,6 +49,21 @@ func NewKeyList() *KeyList {
 	}
 }
 
+// SetThreshold sets how many of the keys in the KeyList must sign. A KeyList with a threshold is serialized as a
+// ThresholdKey; a negative threshold removes it, so that every key must sign.
+func (kl *KeyList) SetThreshold(threshold int) *KeyList {
+	if threshold < 0 {
+		threshold = -1
+	}
+	kl.threshold = threshold
+	return kl
+}
+
+// GetThreshold returns the threshold of the KeyList, or -1 if every key must sign.
+func (kl *KeyList) GetThreshold() int {
+	return kl.threshold
+}
+
 // Add adds a key to the KeyList
 func (kl *KeyList) Add(key Key) *KeyList {
 	kl.keys = append(kl.keys, key)
@@ -93,6 +109,83 @@ func (kl *KeyList) String() string {
 	return s
 }
 
+// RemainingSignatures returns how many more of the list's members must sign before the list is satisfied,
+// given the public keys which have already signed. A list without a threshold needs all of its members,
+// a nested list counts as one member once it is satisfied itself, and contract IDs can never be satisfied
+// by a signature.
+func (kl *KeyList) RemainingSignatures(signedKeys []PublicKey) int {
+	required := len(kl.keys)
+	if kl.threshold >= 0 && kl.threshold < required {
+		required = kl.threshold
+	}
+
+	satisfied := 0
+	for _, key := range kl.keys {
+		if _KeyIsSatisfied(key, signedKeys) {
+			satisfied++
+		}
+	}
+
+	if satisfied >= required {
+		return 0
+	}
+
+	return required - satisfied
+}
+
+func _KeyIsSatisfied(key Key, signedKeys []PublicKey) bool {
+	var publicKey PublicKey
+	switch k := key.(type) {
+	case PublicKey:
+		publicKey = k
+	case *PublicKey:
+		publicKey = *k
+	case PrivateKey:
+		publicKey = k.PublicKey()
+	case *PrivateKey:
+		publicKey = k.PublicKey()
+	case *KeyList:
+		return k.RemainingSignatures(signedKeys) == 0
+	default:
+		return false
+	}
+
+	for _, signed := range signedKeys {
+		if bytes.Equal(signed.BytesRaw(), publicKey.BytesRaw()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// _UnsignedPublicKeys returns the public keys within key which have not signed, skipping any part of the key
+// which is already satisfied. Contract IDs cannot sign and are never listed.
+func _UnsignedPublicKeys(key Key, signedKeys []PublicKey) []PublicKey {
+	if _KeyIsSatisfied(key, signedKeys) {
+		return nil
+	}
+
+	switch k := key.(type) {
+	case PublicKey:
+		return []PublicKey{k}
+	case *PublicKey:
+		return []PublicKey{*k}
+	case PrivateKey:
+		return []PublicKey{k.PublicKey()}
+	case *PrivateKey:
+		return []PublicKey{k.PublicKey()}
+	case *KeyList:
+		unsigned := make([]PublicKey, 0)
+		for _, member := range k.keys {
+			unsigned = append(unsigned, _UnsignedPublicKeys(member, signedKeys)...)
+		}
+		return unsigned
+	default:
+		return nil
+	}
+}
+
 func (kl *KeyList) _ToProtoKey() *services.Key {
 	keys := make([]*services.Key, len(kl.keys))
 	for i, key := range kl.keys {
@@ -134,7 +227,7 @@ func (kl *KeyList) _ToProtoKeyList() *services.KeyList {
 
 func _KeyListFromProtobuf(pb *services.KeyList) (KeyList, error) {
 	if pb == nil {
-		return KeyList{}, errParameterNull
+		return KeyList{}, ErrParameterNull
 	}
 	var keys = make([]Key, len(pb.Keys))
 
@@ -0,0 +1,72 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitKeyListSetThresholdNested(t *testing.T) {
+	t.Parallel()
+
+	publicKeys := make([]PublicKey, 4)
+	for i := range publicKeys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		publicKeys[i] = key.PublicKey()
+	}
+
+	inner := NewKeyList().AddAllPublicKeys(publicKeys[2:]).SetThreshold(1)
+	outer := NewKeyList().Add(publicKeys[0]).Add(publicKeys[1]).Add(inner).SetThreshold(2)
+	require.Equal(t, 2, outer.GetThreshold())
+
+	pb := outer._ToProtoKey()
+	require.Equal(t, uint32(2), pb.GetThresholdKey().GetThreshold())
+	require.Len(t, pb.GetThresholdKey().GetKeys().GetKeys(), 3)
+	nested := pb.GetThresholdKey().GetKeys().GetKeys()[2]
+	require.Equal(t, uint32(1), nested.GetThresholdKey().GetThreshold())
+	require.Len(t, nested.GetThresholdKey().GetKeys().GetKeys(), 2)
+
+	roundTrip, err := _KeyFromProtobuf(pb)
+	require.NoError(t, err)
+	require.Equal(t, outer.String(), roundTrip.String())
+
+	require.Equal(t, 0, outer.RemainingSignatures([]PublicKey{publicKeys[0], publicKeys[3]}))
+	require.Equal(t, 1, outer.RemainingSignatures([]PublicKey{publicKeys[0]}))
+
+	outer.SetThreshold(-5)
+	require.Equal(t, -1, outer.GetThreshold())
+	require.IsType(t, &services.Key_KeyList{}, outer._ToProtoKey().GetKey())
+
+	transaction, err := NewAccountCreateTransaction().
+		SetKey(inner).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		Freeze()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), transaction.build().GetCryptoCreateAccount().GetKey().GetThresholdKey().GetThreshold())
+}
@@ -146,34 +146,102 @@ func _NewKeystore(privateKey []byte, passphrase string) ([]byte, error) {
 	return json.Marshal(keystore)
 }
 
-func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error) {
+// KeystoreMeta describes a keystore as read by KeystoreInspect.
+type KeystoreMeta struct {
+	Version uint8
+	// KDF is the key derivation function, with PRF its pseudorandom function and KDFCount its iteration count
+	KDF      string
+	PRF      string
+	KDFCount int
+	Cipher   string
+}
+
+// KeystoreInspect checks that data is a well-formed keystore this SDK can decrypt and returns its metadata,
+// without the passphrase and without attempting decryption. A wallet can use it to reject a corrupted file
+// before prompting for the passphrase.
+func KeystoreInspect(data []byte) (KeystoreMeta, error) {
 	keyStore := _Keystore{}
+	if err := json.Unmarshal(data, &keyStore); err != nil {
+		return KeystoreMeta{}, _NewErrBadKeyf("malformed keystore: %v", err)
+	}
 
-	err := json.Unmarshal(keystoreBytes, &keyStore)
+	if err := keyStore._CheckSupported(); err != nil {
+		return KeystoreMeta{}, err
+	}
 
-	if err != nil {
-		return PrivateKey{}, err
+	fields := []struct {
+		name   string
+		value  string
+		length int
+	}{
+		{"ciphertext", keyStore.Crypto.CipherText, 0},
+		{"cipherparams.iv", keyStore.Crypto.CipherParams.IV, aes.BlockSize},
+		{"kdfparams.salt", keyStore.Crypto.KDFParams.Salt, 0},
+		{"mac", keyStore.Crypto.Mac, sha512.Size384},
+	}
+	for _, field := range fields {
+		decoded, err := hex.DecodeString(field.value)
+		if err != nil {
+			return KeystoreMeta{}, _NewErrBadKeyf("malformed keystore: %s is not hex: %v", field.name, err)
+		}
+		if len(decoded) == 0 {
+			return KeystoreMeta{}, _NewErrBadKeyf("malformed keystore: %s is missing", field.name)
+		}
+		if field.length != 0 && len(decoded) != field.length {
+			return KeystoreMeta{}, _NewErrBadKeyf("malformed keystore: %s is %d bytes, expected %d", field.name, len(decoded), field.length)
+		}
+	}
+
+	if keyStore.Crypto.KDFParams.Count <= 0 {
+		return KeystoreMeta{}, _NewErrBadKeyf("malformed keystore: kdfparams.c is missing")
 	}
 
+	return KeystoreMeta{
+		Version:  keyStore.Version,
+		KDF:      keyStore.Crypto.KDF,
+		PRF:      keyStore.Crypto.KDFParams.PRF,
+		KDFCount: keyStore.Crypto.KDFParams.Count,
+		Cipher:   keyStore.Crypto.Cipher,
+	}, nil
+}
+
+// _CheckSupported returns an error if the keystore's version or algorithms are not ones this SDK can decrypt.
+func (keyStore _Keystore) _CheckSupported() error {
 	if keyStore.Version != 1 {
 		// todo: change to a switch and handle differently if future _Keystore versions are added
-		return PrivateKey{}, _NewErrBadKeyf("unsupported _Keystore version: %v", keyStore.Version)
+		return _NewErrBadKeyf("unsupported _Keystore version: %v", keyStore.Version)
 	}
 
 	if keyStore.Crypto.KDF != "pbkdf2" {
-		return PrivateKey{}, _NewErrBadKeyf("unsupported KDF: %v", keyStore.Crypto.KDF)
+		return _NewErrBadKeyf("unsupported KDF: %v", keyStore.Crypto.KDF)
 	}
 
 	if keyStore.Crypto.Cipher != Aes128Ctr {
-		return PrivateKey{}, _NewErrBadKeyf("unsupported _Keystore cipher: %v", keyStore.Crypto.Cipher)
+		return _NewErrBadKeyf("unsupported _Keystore cipher: %v", keyStore.Crypto.Cipher)
 	}
 
 	if keyStore.Crypto.KDFParams.PRF != HmacSha256 {
-		return PrivateKey{}, _NewErrBadKeyf(
+		return _NewErrBadKeyf(
 			"unsupported PRF: %v",
 			keyStore.Crypto.KDFParams.PRF)
 	}
 
+	return nil
+}
+
+func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error) {
+	keyStore := _Keystore{}
+
+	err := json.Unmarshal(keystoreBytes, &keyStore)
+
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	if err = keyStore._CheckSupported(); err != nil {
+		return PrivateKey{}, err
+	}
+
 	salt, err := hex.DecodeString(keyStore.Crypto.KDFParams.Salt)
 
 	if err != nil {
@@ -24,6 +24,7 @@ package hedera
  */
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -64,3 +65,29 @@ func TestUnitEncryptAndDecryptKeyStore(t *testing.T) {
 
 	assert.Equal(t, privateKey.ed25519PrivateKey.keyData, ksPrivateKey.ed25519PrivateKey.keyData)
 }
+
+func TestUnitKeystoreInspect(t *testing.T) {
+	t.Parallel()
+
+	meta, err := KeystoreInspect([]byte(testKeystore))
+	require.NoError(t, err)
+	assert.Equal(t, KeystoreMeta{
+		Version:  1,
+		KDF:      "pbkdf2",
+		PRF:      HmacSha256,
+		KDFCount: 262144,
+		Cipher:   Aes128Ctr,
+	}, meta)
+
+	_, err = KeystoreInspect([]byte(testKeystore[:len(testKeystore)-10]))
+	require.ErrorContains(t, err, "malformed keystore")
+
+	_, err = KeystoreInspect([]byte(strings.Replace(testKeystore, `"iv":"c3198c3529fef9c5e2886f19c479683e"`, `"iv":"zz"`, 1)))
+	require.ErrorContains(t, err, "cipherparams.iv is not hex")
+
+	_, err = KeystoreInspect([]byte(strings.Replace(testKeystore, `"mac":"f6f7`, `"mac":"`, 1)))
+	require.ErrorContains(t, err, "mac is 46 bytes, expected 48")
+
+	_, err = KeystoreInspect([]byte(strings.Replace(testKeystore, `"kdf":"pbkdf2"`, `"kdf":"scrypt"`, 1)))
+	require.ErrorContains(t, err, "unsupported KDF: scrypt")
+}
@@ -48,7 +48,7 @@ func (liveHash *LiveHash) _ToProtobuf() *services.LiveHash {
 
 func _LiveHashFromProtobuf(hash *services.LiveHash) (LiveHash, error) {
 	if hash == nil {
-		return LiveHash{}, errParameterNull
+		return LiveHash{}, ErrParameterNull
 	}
 
 	var keyList KeyList
@@ -91,7 +91,7 @@ func (liveHash LiveHash) ToBytes() []byte {
 // LiveHashFromBytes returns a LiveHash object from a raw byte array
 func LiveHashFromBytes(data []byte) (LiveHash, error) {
 	if data == nil {
-		return LiveHash{}, errByteArrayNull
+		return LiveHash{}, ErrByteArrayNull
 	}
 	pb := services.LiveHash{}
 	err := protobuf.Unmarshal(data, &pb)
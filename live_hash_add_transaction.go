@@ -179,6 +179,19 @@ func (tx *LiveHashAddTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *LiveHashAddTransaction) SignWithSigner(signer Signer) *LiveHashAddTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this LiveHashAddTransaction.
+func (tx *LiveHashAddTransaction) SetSigningKeys(keys []PublicKey) *LiveHashAddTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *LiveHashAddTransaction) AddSignature(publicKey PublicKey, signature []byte) *LiveHashAddTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -268,6 +281,12 @@ func (tx *LiveHashAddTransaction) SetMaxBackoff(max time.Duration) *LiveHashAddT
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *LiveHashAddTransaction) SetSingleNode(singleNode bool) *LiveHashAddTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *LiveHashAddTransaction) SetMinBackoff(min time.Duration) *LiveHashAddTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -322,6 +341,11 @@ func (tx *LiveHashAddTransaction) buildScheduled() (*services.SchedulableTransac
 	return nil, errors.New("cannot schedule `LiveHashAddTransaction`")
 }
 
+// IsSchedulable returns false, as a LiveHashAddTransaction can't be scheduled.
+func (tx *LiveHashAddTransaction) IsSchedulable() bool {
+	return false
+}
+
 func (tx *LiveHashAddTransaction) buildProtoBody() *services.CryptoAddLiveHashTransactionBody {
 	body := &services.CryptoAddLiveHashTransactionBody{
 		LiveHash: &services.LiveHash{},
@@ -110,6 +110,19 @@ func (tx *LiveHashDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *LiveHashDeleteTransaction) SignWithSigner(signer Signer) *LiveHashDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this LiveHashDeleteTransaction.
+func (tx *LiveHashDeleteTransaction) SetSigningKeys(keys []PublicKey) *LiveHashDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *LiveHashDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *LiveHashDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -189,6 +202,12 @@ func (tx *LiveHashDeleteTransaction) SetMaxBackoff(max time.Duration) *LiveHashD
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *LiveHashDeleteTransaction) SetSingleNode(singleNode bool) *LiveHashDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *LiveHashDeleteTransaction) SetMinBackoff(min time.Duration) *LiveHashDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -244,6 +263,11 @@ func (tx *LiveHashDeleteTransaction) buildScheduled() (*services.SchedulableTran
 	return nil, errors.New("cannot schedule `LiveHashDeleteTransaction`")
 }
 
+// IsSchedulable returns false, as a LiveHashDeleteTransaction can't be scheduled.
+func (tx *LiveHashDeleteTransaction) IsSchedulable() bool {
+	return false
+}
+
 func (tx *LiveHashDeleteTransaction) buildProtoBody() *services.CryptoDeleteLiveHashTransactionBody {
 	body := &services.CryptoDeleteLiveHashTransactionBody{}
 
@@ -99,6 +99,19 @@ func (q *LiveHashQuery) SetMaxQueryPayment(maxPayment Hbar) *LiveHashQuery {
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *LiveHashQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *LiveHashQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *LiveHashQuery) SetRequestStateProof(request bool) *LiveHashQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *LiveHashQuery) SetQueryPayment(paymentAmount Hbar) *LiveHashQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -118,6 +131,12 @@ func (q *LiveHashQuery) SetMaxBackoff(max time.Duration) *LiveHashQuery {
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *LiveHashQuery) SetSingleNode(singleNode bool) *LiveHashQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *LiveHashQuery) SetMinBackoff(min time.Duration) *LiveHashQuery {
 	q.Query.SetMinBackoff(min)
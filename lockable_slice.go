@@ -34,7 +34,7 @@ func _NewLockableSlice() *_LockableSlice {
 
 func (this *_LockableSlice) _RequireNotLocked() {
 	if this.locked {
-		panic(errLockedSlice)
+		panic(ErrLockedSlice)
 	}
 }
 
@@ -37,7 +37,7 @@ func _ManagedNodeAddressFromString(str string) (*_ManagedNodeAddress, error) {
 	hostAndPortMatch := hostAndPort.FindStringSubmatch(str)
 
 	if len(hostAndPortMatch) > 1 {
-		port, err := strconv.ParseUint(hostAndPortMatch[2], 10, 64)
+		port, err := strconv.ParseUint(hostAndPortMatch[2], 10, 16)
 		if err != nil {
 			return nil, err
 		}
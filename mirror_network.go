@@ -20,7 +20,10 @@ package hedera
  *
  */
 
-import "math/rand"
+import (
+	"fmt"
+	"math/rand"
+)
 
 type _MirrorNetwork struct {
 	_ManagedNetwork
@@ -36,7 +39,7 @@ func (network *_MirrorNetwork) _SetNetwork(newNetwork []string) (err error) {
 	newMirrorNetwork := make(map[string]_IManagedNode)
 	for _, url := range newNetwork {
 		if newMirrorNetwork[url], err = _NewMirrorNode(url); err != nil {
-			return err
+			return fmt.Errorf("invalid mirror node endpoint %q, expected host:port: %w", url, err)
 		}
 	}
 
@@ -83,6 +83,36 @@ func GenerateMnemonic12() (Mnemonic, error) {
 	return Mnemonic{mnemonic}, nil
 }
 
+// MnemonicFromEntropy creates the 12 word mnemonic for 16 bytes of entropy or the 24 word mnemonic for 32 bytes
+// of entropy, computing its BIP-39 checksum.
+func MnemonicFromEntropy(entropy []byte) (Mnemonic, error) {
+	if len(entropy) != 16 && len(entropy) != 32 {
+		return Mnemonic{}, fmt.Errorf("invalid entropy length: %v bytes, must be 16 or 32", len(entropy))
+	}
+
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return Mnemonic{}, err
+	}
+
+	return Mnemonic{mnemonic}, nil
+}
+
+// Entropy returns the entropy a 12 or 24 word mnemonic encodes, 16 or 32 bytes without the checksum.
+// MnemonicFromEntropy turns it back into the mnemonic. Legacy 22 word mnemonics are not supported.
+func (m Mnemonic) Entropy() ([]byte, error) {
+	words := m.Words()
+	if len(words) != 12 && len(words) != 24 {
+		return nil, ErrMnemonicInvalidLength{WordCount: len(words)}
+	}
+
+	if err := _ValidateMnemonicWords(words); err != nil {
+		return nil, err
+	}
+
+	return bip39.EntropyFromMnemonic(m.words)
+}
+
 // MnemonicFromString creates a mnemonic from a string of 24 words separated by spaces
 //
 // Keys are lazily generated
@@ -102,25 +132,66 @@ func (m Mnemonic) Words() []string {
 
 // NewMnemonic Creates a mnemonic from a slice of 24 strings
 //
-// Keys are lazily generated
+// Keys are lazily generated. The error describes what is wrong with the words the same way as Validate.
 func NewMnemonic(words []string) (Mnemonic, error) {
-	joinedString := strings.Join(words, " ")
+	if err := _ValidateMnemonicWords(words); err != nil {
+		return Mnemonic{}, err
+	}
+
+	return Mnemonic{
+		words: strings.Join(words, " "),
+	}, nil
+}
+
+// Validate checks the mnemonic's length, that every word is in the BIP-39 word list (or the legacy word list
+// for 22 word mnemonics) and its checksum. It returns ErrMnemonicInvalidLength, ErrMnemonicUnknownWords listing
+// the position of every unknown word, or ErrMnemonicChecksumMismatch, so a wallet can point at the wrong word.
+func (m Mnemonic) Validate() error {
+	return _ValidateMnemonicWords(m.Words())
+}
 
-	if len(words) == 24 || len(words) == 12 || len(words) == 22 {
+func _ValidateMnemonicWords(words []string) error {
+	if len(words) != 12 && len(words) != 22 && len(words) != 24 {
+		return ErrMnemonicInvalidLength{WordCount: len(words)}
+	}
+
+	unknown := ErrMnemonicUnknownWords{}
+	for i, word := range words {
+		var known bool
 		if len(words) == 22 { //nolint
-			return Mnemonic{
-				words: joinedString,
-			}._LegacyValidate()
-		} else if bip39.IsMnemonicValid(joinedString) {
-			return Mnemonic{
-				words: joinedString,
-			}, nil
+			for _, legacyWord := range legacy {
+				if word == legacyWord {
+					known = true
+					break
+				}
+			}
 		} else {
-			return Mnemonic{}, fmt.Errorf("invalid mnemonic composition")
+			_, known = bip39.GetWordIndex(word)
 		}
-	} else {
-		return Mnemonic{}, fmt.Errorf("invalid mnemonic string")
+
+		if !known {
+			unknown.Indices = append(unknown.Indices, i)
+			unknown.Words = append(unknown.Words, word)
+		}
+	}
+
+	if len(unknown.Indices) > 0 {
+		return unknown
+	}
+
+	if len(words) == 22 { //nolint
+		if _, err := (Mnemonic{words: strings.Join(words, " ")})._LegacyValidate(); err != nil {
+			return ErrMnemonicChecksumMismatch
+		}
+
+		return nil
 	}
+
+	if !bip39.IsMnemonicValid(strings.Join(words, " ")) {
+		return ErrMnemonicChecksumMismatch
+	}
+
+	return nil
 }
 
 func (m Mnemonic) _LegacyValidate() (Mnemonic, error) {
@@ -275,14 +346,18 @@ func (m Mnemonic) _ToLegacyEntropy2() ([]byte, error) {
 	return entropy, nil
 }
 
+// _ToSeed computes the BIP-39 seed. As BIP-39 requires, both the mnemonic sentence and the passphrase
+// are normalized to NFKD before hashing, so composed and decomposed forms of the same passphrase
+// (e.g. "ä" as one code point or as "a" plus a combining diaeresis) give the same keys as other wallets.
 func (m Mnemonic) _ToSeed(passPhrase string) []byte {
 	passPhraseNFKD := norm.NFKD.String(passPhrase)
 	salt := []byte("mnemonic" + passPhraseNFKD)
-	seed := pbkdf2.Key([]byte(m.String()), salt, 2048, 64, sha512.New)
+	seed := pbkdf2.Key([]byte(norm.NFKD.String(m.String())), salt, 2048, 64, sha512.New)
 	return seed
 }
 
 // ToStandardEd25519PrivateKey converts a mnemonic to a standard ed25519 private key
+// The mnemonic and passphrase are NFKD normalized, as BIP-39 requires.
 func (m Mnemonic) ToStandardEd25519PrivateKey(passPhrase string, index uint32) (PrivateKey, error) {
 	seed := m._ToSeed(passPhrase)
 	derivedKey, err := _Ed25519PrivateKeyFromSeed(seed)
@@ -311,36 +386,48 @@ func (m Mnemonic) ToStandardEd25519PrivateKey(passPhrase string, index uint32) (
 }
 
 // ToStandardECDSAsecp256k1PrivateKey converts a mnemonic to a standard ecdsa secp256k1 private key
+// The mnemonic and passphrase are NFKD normalized, as BIP-39 requires.
 func (m Mnemonic) ToStandardECDSAsecp256k1PrivateKey(passPhrase string, index uint32) (PrivateKey, error) {
+	privateKey, err := m._ToECDSAsecp256k1PrivateKey(passPhrase, []uint32{
+		ToHardenedIndex(44),
+		ToHardenedIndex(3030),
+		ToHardenedIndex(0),
+		0,
+		index})
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	return PrivateKey{
+		ecdsaPrivateKey: privateKey,
+	}, nil
+}
+
+// _ToECDSAsecp256k1PrivateKey derives the ecdsa secp256k1 key along path, whose indices carry their own
+// hardened bit, from the mnemonic's BIP-32 master key.
+func (m Mnemonic) _ToECDSAsecp256k1PrivateKey(passPhrase string, path []uint32) (*_ECDSAPrivateKey, error) {
 	seed := m._ToSeed(passPhrase)
 	derivedKey, err := _ECDSAPrivateKeyFromSeed(seed)
 	if err != nil {
-		return PrivateKey{}, err
+		return nil, err
 	}
 
 	keyBytes, chainCode := derivedKey.keyData.D.Bytes(), derivedKey.chainCode
-	for _, i := range []uint32{
-		ToHardenedIndex(44),
-		ToHardenedIndex(3030),
-		ToHardenedIndex(0),
-		0,
-		index} {
+	for _, i := range path {
 		keyBytes, chainCode, err = _DeriveECDSAChildKey(keyBytes, chainCode, i)
 		if err != nil {
-			return PrivateKey{}, err
+			return nil, err
 		}
 	}
 
 	privateKey, err := _ECDSAPrivateKeyFromBytes(keyBytes)
 	if err != nil {
-		return PrivateKey{}, err
+		return nil, err
 	}
 
 	privateKey.chainCode = chainCode
 
-	return PrivateKey{
-		ecdsaPrivateKey: privateKey,
-	}, nil
+	return privateKey, nil
 }
 
 func _ConvertRadix(nums []int, fromRadix int, toRadix int, toLength int) []uint8 {
@@ -193,6 +193,76 @@ func TestUnitMnemonicBreaksWithBadLength(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestUnitMnemonicValidateReportsWhatIsWrong(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic12WordString)
+	require.NoError(t, err)
+	require.NoError(t, mnemonic.Validate())
+
+	words := strings.Split(mnemonic12WordString, " ")
+	words[2] = "tomorow"
+	words[7] = "aire"
+	_, err = NewMnemonic(words)
+	var unknown ErrMnemonicUnknownWords
+	require.ErrorAs(t, err, &unknown)
+	require.Equal(t, []int{2, 7}, unknown.Indices)
+	require.Equal(t, []string{"tomorow", "aire"}, unknown.Words)
+
+	words = strings.Split(mnemonic12WordString, " ")
+	words[0], words[1] = words[1], words[0]
+	_, err = NewMnemonic(words)
+	require.ErrorIs(t, err, ErrMnemonicChecksumMismatch)
+
+	_, err = NewMnemonic(words[:11])
+	var length ErrMnemonicInvalidLength
+	require.ErrorAs(t, err, &length)
+	require.Equal(t, 11, length.WordCount)
+
+	legacyWords := strings.Split(mnemonicLegacyV1String, " ")
+	legacyWords[21] = "abandon"
+	_, err = NewMnemonic(legacyWords)
+	require.ErrorAs(t, err, &unknown)
+	require.Equal(t, []int{21}, unknown.Indices)
+}
+
+func TestUnitMnemonicEntropyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	vectors := []struct {
+		entropy  string
+		mnemonic string
+	}{
+		{"00000000000000000000000000000000", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"},
+		{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f", "legal winner thank year wave sausage worth useful legal winner thank yellow"},
+		{"80808080808080808080808080808080", "letter advice cage absurd amount doctor acoustic avoid letter advice cage above"},
+		{"ffffffffffffffffffffffffffffffff", "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong"},
+		{"0000000000000000000000000000000000000000000000000000000000000000", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art"},
+	}
+
+	for _, vector := range vectors {
+		entropy, err := hex.DecodeString(vector.entropy)
+		require.NoError(t, err)
+
+		mnemonic, err := MnemonicFromEntropy(entropy)
+		require.NoError(t, err)
+		require.Equal(t, vector.mnemonic, mnemonic.String())
+
+		roundTrip, err := mnemonic.Entropy()
+		require.NoError(t, err)
+		require.Equal(t, entropy, roundTrip)
+	}
+
+	_, err := MnemonicFromEntropy(make([]byte, 24))
+	require.Error(t, err)
+
+	legacy, err := MnemonicFromString(mnemonicLegacyV1String)
+	require.NoError(t, err)
+	_, err = legacy.Entropy()
+	var length ErrMnemonicInvalidLength
+	require.ErrorAs(t, err, &length)
+}
+
 func TestBIP39NFKD(t *testing.T) {
 	passphrase := "\u03B4\u03BF\u03BA\u03B9\u03BC\u03AE"
 	expectedPrivateKey := "302e020100300506032b6570042204203fefe1000db9485372851d542453b07e7970de4e2ecede7187d733ac037f4d2c"
@@ -565,3 +635,33 @@ func TestToStandardECDSAsecp256k1PrivateKey2(t *testing.T) {
 	assert.Equal(t, key6.PublicKey().StringRaw(), test6PublicKey)
 	assert.Equal(t, hex.EncodeToString(key6.ecdsaPrivateKey.chainCode), test6ChainCode)
 }
+
+func TestUnitMnemonicPassphraseNFKD(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+
+	// "Pässphräse ☃ ﬁ" with precomposed umlauts and the "ﬁ" ligature, and the same passphrase
+	// already decomposed, with combining diaereses and a plain "fi"
+	composed := "Pässphräse ☃ ﬁ"
+	decomposed := "Pässphräse ☃ fi"
+
+	// Seed from the reference BIP-39 implementation (python-mnemonic)
+	require.Equal(t,
+		"22a1b2598283f273ceb9dc2e3764cbc15e3d16cfdbdfffa0cf6b01180669042892665fb41a49ee033be35d2bde67bb8bfc0b3d34e8fdaaeb5481aa7ae8499a4e",
+		hex.EncodeToString(mnemonic._ToSeed(composed)))
+	require.Equal(t, mnemonic._ToSeed(composed), mnemonic._ToSeed(decomposed))
+
+	composedKey, err := mnemonic.ToStandardEd25519PrivateKey(composed, 0)
+	require.NoError(t, err)
+	decomposedKey, err := mnemonic.ToStandardEd25519PrivateKey(decomposed, 0)
+	require.NoError(t, err)
+	require.Equal(t, composedKey.String(), decomposedKey.String())
+
+	composedKey, err = PrivateKeyFromMnemonic(mnemonic, composed)
+	require.NoError(t, err)
+	decomposedKey, err = PrivateKeyFromMnemonic(mnemonic, decomposed)
+	require.NoError(t, err)
+	require.Equal(t, composedKey.String(), decomposedKey.String())
+}
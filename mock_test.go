@@ -26,6 +26,7 @@ package hedera
 import (
 	"context"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -487,6 +488,7 @@ func NewMockClientAndServer(allNodeResponses [][]interface{}) (*Client, *MockSer
 		minBackoff:                      250 * time.Millisecond,
 		maxBackoff:                      8 * time.Second,
 		defaultRegenerateTransactionIDs: true,
+		feeCache:                        _NewFeeCache(),
 		defaultNetworkUpdatePeriod:      24 * time.Hour,
 		networkUpdateContext:            ctx,
 		cancelNetworkUpdate:             cancel,
@@ -724,12 +726,16 @@ func TestUnitMockAccountInfoQueryNoNodeSet(t *testing.T) {
 
 func NewMockHandler(responses []interface{}) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
 	index := 0
+	var lock sync.Mutex
 	return func(_srv interface{}, _ctx context.Context, dec func(interface{}) error, _interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		lock.Lock()
 		if index >= len(responses) {
+			lock.Unlock()
 			return nil, status.New(codes.Aborted, "No response found").Err()
 		}
 		response := responses[index]
 		index = index + 1
+		lock.Unlock()
 
 		switch response := response.(type) {
 		case error:
@@ -806,7 +812,8 @@ func NewMockServer(responses []interface{}) (server *MockServer) {
 	}
 
 	go func() {
-		if err = server.server.Serve(server.listener); err != nil {
+		// A test which never reaches the server may close it before Serve starts
+		if err := server.server.Serve(server.listener); err != nil && err != grpc.ErrServerStopped {
 			panic(err)
 		}
 	}()
@@ -22,18 +22,22 @@ package hedera
 
 import (
 	"math/rand"
+	"sort"
+	"sync/atomic"
 	"time"
 )
 
 type _Network struct {
 	_ManagedNetwork
-	addressBook map[AccountID]NodeAddress
+	addressBook   map[AccountID]NodeAddress
+	nextNodeIndex *uint64
 }
 
 func _NewNetwork() _Network {
 	return _Network{
 		_ManagedNetwork: _NewManagedNetwork(),
 		addressBook:     nil,
+		nextNodeIndex:   new(uint64),
 	}
 }
 
@@ -134,24 +138,66 @@ func (network *_Network) _SetLedgerID(id LedgerID) {
 	}
 }
 
-func (network *_Network) _GetNodeAccountIDsForExecute() []AccountID { //nolint
-	nodes := make([]AccountID, 0)
+// _GetNodeAccountIDsForExecute picks the nodes a transaction is frozen with, ordered by policy. Nodes which are
+// backed off have been removed from healthyNodes, so they come last and are only used to make up the number of
+// nodes for a transaction, unless healthyOnly is set. If no node is healthy, all nodes are used either way.
+func (network *_Network) _GetNodeAccountIDsForExecute(policy NodeSelectionPolicy, healthyOnly bool) []AccountID {
 	nodesForTransaction := network._GetNumberOfNodesForTransaction()
 
 	network.healthyNodesMutex.RLock()
-	defer network.healthyNodesMutex.RUnlock()
+	healthy := make([]_IManagedNode, len(network.healthyNodes))
+	copy(healthy, network.healthyNodes)
+	unhealthy := make([]_IManagedNode, 0)
+outer:
+	for _, node := range network.nodes {
+		for _, healthyNode := range healthy {
+			if node == healthyNode {
+				continue outer
+			}
+		}
+		unhealthy = append(unhealthy, node)
+	}
+	network.healthyNodesMutex.RUnlock()
 
-	healthyNodes := make([]_IManagedNode, len(network.healthyNodes))
-	copy(healthyNodes, network.healthyNodes)
+	candidates := network._OrderNodes(healthy, policy)
+	if len(candidates) < nodesForTransaction && (!healthyOnly || len(candidates) == 0) {
+		candidates = append(candidates, network._OrderNodes(unhealthy, policy)...)
+	}
+
+	if nodesForTransaction > len(candidates) {
+		nodesForTransaction = len(candidates)
+	}
 
-	// shuffle the nodes, so that the first node is not always the same
-	for i := range healthyNodes {
-		j := rand.Intn(i + 1) // #nosec
-		healthyNodes[i], healthyNodes[j] = healthyNodes[j], healthyNodes[i]
+	nodes := make([]AccountID, 0, nodesForTransaction)
+	for _, node := range candidates[:nodesForTransaction] {
+		nodes = append(nodes, node.(*_Node).accountID)
 	}
-	for i := 0; i < nodesForTransaction; i++ {
-		nodes = append(nodes, healthyNodes[i].(*_Node).accountID)
+	return nodes
+}
+
+// _OrderNodes sorts nodes in place in the order policy picks them.
+func (network *_Network) _OrderNodes(nodes []_IManagedNode, policy NodeSelectionPolicy) []_IManagedNode {
+	switch policy {
+	case NodeSelectionPolicyRoundRobin:
+		sort.Slice(nodes, func(i, j int) bool {
+			return nodes[i].(*_Node).accountID.Compare(nodes[j].(*_Node).accountID) < 0
+		})
+		if len(nodes) > 0 {
+			start := int((atomic.AddUint64(network.nextNodeIndex, 1) - 1) % uint64(len(nodes)))
+			nodes = append(nodes[start:len(nodes):len(nodes)], nodes[:start]...)
+		}
+	case NodeSelectionPolicyLeastRecentlyUsed:
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return nodes[i]._GetLastUsed().Before(nodes[j]._GetLastUsed())
+		})
+	default:
+		// shuffle the nodes, so that the first node is not always the same
+		for i := range nodes {
+			j := rand.Intn(i + 1) // #nosec
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
 	}
+
 	return nodes
 }
 
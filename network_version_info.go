@@ -61,7 +61,7 @@ func (version *NetworkVersionInfo) ToBytes() []byte {
 // NetworkVersionInfoFromBytes returns the NetworkVersionInfo from a raw byte array
 func NetworkVersionInfoFromBytes(data []byte) (NetworkVersionInfo, error) {
 	if data == nil {
-		return NetworkVersionInfo{}, errByteArrayNull
+		return NetworkVersionInfo{}, ErrByteArrayNull
 	}
 	pb := services.NetworkGetVersionInfoResponse{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -67,6 +67,19 @@ func (q *NetworkVersionInfoQuery) SetMaxQueryPayment(maxPayment Hbar) *NetworkVe
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *NetworkVersionInfoQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *NetworkVersionInfoQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *NetworkVersionInfoQuery) SetRequestStateProof(request bool) *NetworkVersionInfoQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *NetworkVersionInfoQuery) SetQueryPayment(paymentAmount Hbar) *NetworkVersionInfoQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -92,6 +105,12 @@ func (q *NetworkVersionInfoQuery) SetMaxBackoff(max time.Duration) *NetworkVersi
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *NetworkVersionInfoQuery) SetSingleNode(singleNode bool) *NetworkVersionInfoQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *NetworkVersionInfoQuery) SetMinBackoff(min time.Duration) *NetworkVersionInfoQuery {
 	q.Query.SetMinBackoff(min)
@@ -64,6 +64,18 @@ func (book NodeAddressBook) ToBytes() []byte {
 	return data
 }
 
+// GetNodeInfos returns the nodes of the address book, in address book order. It can be used on the
+// result of an AddressBookQuery without updating the client's network.
+func (book NodeAddressBook) GetNodeInfos() []NodeInfo {
+	infos := make([]NodeInfo, 0, len(book.NodeAddresses))
+
+	for _, node := range book.NodeAddresses {
+		infos = append(infos, _NodeInfoFromNodeAddress(node))
+	}
+
+	return infos
+}
+
 func (book NodeAddressBook) _ToMap() (result map[AccountID]NodeAddress) {
 	result = map[AccountID]NodeAddress{}
 
@@ -81,7 +93,7 @@ func (book NodeAddressBook) _ToMap() (result map[AccountID]NodeAddress) {
 // NodeAddressBookFromBytes returns the NodeAddressBook from a raw byte array
 func NodeAddressBookFromBytes(data []byte) (NodeAddressBook, error) {
 	if data == nil {
-		return NodeAddressBook{}, errByteArrayNull
+		return NodeAddressBook{}, ErrByteArrayNull
 	}
 	pb := services.NodeAddressBook{}
 	err := protobuf.Unmarshal(data, &pb)
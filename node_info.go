@@ -0,0 +1,51 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// NodeInfo is the information about a single node in a NodeAddressBook, with its endpoints as
+// "host:port" strings.
+type NodeInfo struct {
+	AccountID   AccountID
+	NodeID      int64
+	Endpoints   []string
+	CertHash    []byte
+	Description string
+}
+
+func _NodeInfoFromNodeAddress(address NodeAddress) NodeInfo {
+	accountID := AccountID{}
+	if address.AccountID != nil {
+		accountID = *address.AccountID
+	}
+
+	endpoints := make([]string, 0, len(address.Addresses))
+	for _, endpoint := range address.Addresses {
+		endpoints = append(endpoints, endpoint.String())
+	}
+
+	return NodeInfo{
+		AccountID:   accountID,
+		NodeID:      address.NodeID,
+		Endpoints:   endpoints,
+		CertHash:    address.CertHash,
+		Description: address.Description,
+	}
+}
@@ -93,6 +93,19 @@ func (tx *PrngTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *PrngTransaction) SignWithSigner(signer Signer) *PrngTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this PrngTransaction.
+func (tx *PrngTransaction) SetSigningKeys(keys []PublicKey) *PrngTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *PrngTransaction) AddSignature(publicKey PublicKey, signature []byte) *PrngTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -172,6 +185,12 @@ func (tx *PrngTransaction) SetMaxBackoff(max time.Duration) *PrngTransaction {
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *PrngTransaction) SetSingleNode(singleNode bool) *PrngTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *PrngTransaction) SetMinBackoff(min time.Duration) *PrngTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -20,8 +20,35 @@ package hedera
  *
  */
 
+import "github.com/hashgraph/hedera-protobufs-go/services"
+
 // ProxyStaker is an information about a single account that is proxy staking
 type ProxyStaker struct {
 	AccountID AccountID
 	Amount    Hbar
 }
+
+func _ProxyStakerFromProtobuf(pb *services.ProxyStaker) ProxyStaker {
+	if pb == nil {
+		return ProxyStaker{}
+	}
+
+	accountID := AccountID{}
+	if id := _AccountIDFromProtobuf(pb.AccountID); id != nil {
+		accountID = *id
+	}
+
+	return ProxyStaker{
+		AccountID: accountID,
+		Amount:    HbarFromTinybar(pb.Amount),
+	}
+}
+
+func _ProxyStakersFromProtobuf(pb *services.AllProxyStakers) []ProxyStaker {
+	stakers := make([]ProxyStaker, len(pb.GetProxyStaker()))
+	for i, element := range pb.GetProxyStaker() {
+		stakers[i] = _ProxyStakerFromProtobuf(element)
+	}
+
+	return stakers
+}
@@ -40,7 +40,10 @@ type Query struct {
 	paymentTransactions []*services.Transaction
 	maxQueryPayment     Hbar
 	queryPayment        Hbar
+	paymentMaxFee       Hbar
 	timestamp           time.Time
+	responseHeader      ResponseHeader
+	requestStateProof   bool
 
 	isPaymentRequired bool
 }
@@ -59,8 +62,6 @@ type QueryInterface interface {
 // -------- Executable functions ----------
 
 func _NewQuery(isPaymentRequired bool, header *services.QueryHeader) Query {
-	minBackoff := 250 * time.Millisecond
-	maxBackoff := 8 * time.Second
 	return Query{
 		pb:                    &services.Query{},
 		pbHeader:              header,
@@ -69,10 +70,9 @@ func _NewQuery(isPaymentRequired bool, header *services.QueryHeader) Query {
 		isPaymentRequired:     isPaymentRequired,
 		maxQueryPayment:       NewHbar(0),
 		queryPayment:          NewHbar(0),
+		paymentMaxFee:         NewHbar(1),
 		executable: executable{
 			nodeAccountIDs: _NewLockableSlice(),
-			maxBackoff:     &maxBackoff,
-			minBackoff:     &minBackoff,
 			maxRetry:       10,
 		},
 	}
@@ -100,10 +100,43 @@ func (q *Query) GetQueryPayment() Hbar {
 	return q.queryPayment
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+// It defaults to 1 hbar; raise it when the payment itself fails with INSUFFICIENT_TX_FEE on a congested network.
+func (q *Query) SetPaymentTransactionMaxFee(maxFee Hbar) *Query {
+	q.paymentMaxFee = maxFee
+	return q
+}
+
+// GetPaymentTransactionMaxFee returns the max transaction fee of the payment transaction generated for this query.
+func (q *Query) GetPaymentTransactionMaxFee() Hbar {
+	return q.paymentMaxFee
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer (the ANSWER_STATE_PROOF
+// response type). The proof bytes are available from GetResponseHeader after execution; execution fails with
+// ErrStateProofNotReturned if the node answers without one.
+func (q *Query) SetRequestStateProof(request bool) *Query {
+	q.requestStateProof = request
+	return q
+}
+
+// GetRequestStateProof returns whether a state proof is requested from the node.
+func (q *Query) GetRequestStateProof() bool {
+	return q.requestStateProof
+}
+
+// GetResponseHeader returns the header of the last response received for this query, exposing the cost,
+// response type and state proof reported by the node. It is empty until the query has been executed.
+func (q *Query) GetResponseHeader() ResponseHeader {
+	return q.responseHeader
+}
+
 // GetCost returns the fee that would be charged to get the requested information (if a cost was requested).
 func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
-	if client == nil || client.operator == nil {
-		return Hbar{}, errNoClientProvided
+	if client == nil {
+		return Hbar{}, ErrNoClientProvided
+	} else if client.operator == nil {
+		return Hbar{}, ErrNoOperator
 	}
 
 	var err error
@@ -114,7 +147,7 @@ func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
 	}
 	q.paymentTransactions = make([]*services.Transaction, 0)
 	if !q.nodeAccountIDs.locked {
-		q.SetNodeAccountIDs([]AccountID{client.network._GetNode().accountID})
+		q.SetNodeAccountIDs([]AccountID{client._NodeAccountIDForQuery()})
 	}
 
 	q.pb = e.buildQuery()
@@ -124,6 +157,9 @@ func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
 	}
 
 	q.pbHeader.ResponseType = services.ResponseType_COST_ANSWER
+	if q.requestStateProof {
+		q.pbHeader.ResponseType = services.ResponseType_COST_ANSWER_STATE_PROOF
+	}
 	q.paymentTransactionIDs._Advance()
 	resp, err := _Execute(client, e)
 
@@ -132,12 +168,13 @@ func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
 	}
 
 	queryResp := e.getQueryResponse(resp.(*services.Response))
+	q.responseHeader = _ResponseHeaderFromProtobuf(queryResp.GetHeader())
 	cost := int64(queryResp.GetHeader().Cost)
 
 	return HbarFromTinybar(cost), nil
 }
 
-func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar) (*services.Transaction, error) {
+func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar, maxFee Hbar) (*services.Transaction, error) {
 	accountAmounts := make([]*services.AccountAmount, 0)
 	accountAmounts = append(accountAmounts, &services.AccountAmount{
 		AccountID: nodeAccountID._ToProtobuf(),
@@ -151,7 +188,7 @@ func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID Acc
 	body := services.TransactionBody{
 		TransactionID:  transactionID._ToProtobuf(),
 		NodeAccountID:  nodeAccountID._ToProtobuf(),
-		TransactionFee: uint64(NewHbar(1).tinybar),
+		TransactionFee: uint64(maxFee.tinybar),
 		TransactionValidDuration: &services.Duration{
 			Seconds: 120,
 		},
@@ -166,10 +203,13 @@ func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID Acc
 
 	bodyBytes, err := protobuf.Marshal(&body)
 	if err != nil {
-		return nil, errors.Wrap(err, "error serializing Query body")
+		return nil, ErrSerialization{Message: "error serializing Query body", Err: err}
 	}
 
-	signature := operator.signer(bodyBytes)
+	signature, err := operator.signer.sign(bodyBytes)
+	if err != nil {
+		return nil, ErrSignerFailed{PublicKey: operator.publicKey, Err: err}
+	}
 	sigPairs := make([]*services.SignaturePair, 0)
 	sigPairs = append(sigPairs, operator.publicKey._ToSignaturePairProtobuf(signature))
 
@@ -203,8 +243,10 @@ func (q *Query) SetPaymentTransactionID(transactionID TransactionID) *Query {
 
 func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, error) {
 	q.client = client
-	if client == nil || client.operator == nil {
-		return nil, errNoClientProvided
+	if client == nil {
+		return nil, ErrNoClientProvided
+	} else if client.operator == nil {
+		return nil, ErrNoOperator
 	}
 
 	var err error
@@ -240,16 +282,23 @@ func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, e
 
 	q.paymentTransactions = make([]*services.Transaction, 0)
 	if !q.nodeAccountIDs.locked {
-		q.SetNodeAccountIDs([]AccountID{client.network._GetNode().accountID})
+		q.SetNodeAccountIDs([]AccountID{client._NodeAccountIDForQuery()})
 	}
 
 	q.pb = e.buildQuery()
 	q.pbHeader.ResponseType = services.ResponseType_ANSWER_ONLY
+	if q.requestStateProof {
+		q.pbHeader.ResponseType = services.ResponseType_ANSWER_STATE_PROOF
+	}
 
 	resp, err := _Execute(client, e)
 	if err != nil {
 		return nil, err
 	}
+	q.responseHeader = _ResponseHeaderFromProtobuf(e.getQueryResponse(resp.(*services.Response)).GetHeader())
+	if q.requestStateProof && len(q.responseHeader.StateProof) == 0 {
+		return nil, ErrStateProofNotReturned
+	}
 
 	return resp.(*services.Response), nil
 }
@@ -271,12 +320,13 @@ func (q *Query) generatePayments(client *Client, cost Hbar) (*services.Transacti
 	var tx *services.Transaction
 	var err error
 	for _, nodeID := range q.nodeAccountIDs.slice {
-		txnID := TransactionIDGenerate(client.operator.accountID)
+		txnID := client._GenerateTransactionID(client.operator.accountID)
 		tx, err = _QueryMakePaymentTransaction(
 			txnID,
 			nodeID.(AccountID),
 			client.operator,
 			cost,
+			q.paymentMaxFee,
 		)
 		if err != nil {
 			return nil, err
@@ -290,16 +340,24 @@ func (q *Query) advanceRequest() {
 	q.nodeAccountIDs._Advance()
 }
 
-func (q *Query) makeRequest() interface{} {
-	if q.client != nil {
-		tx, err := q.generatePayments(q.client, q.queryPayment)
-		if err != nil {
-			return q.pb
-		}
-		q.pbHeader.Payment = tx
+func (q *Query) makeRequest() (interface{}, error) {
+	if q.client == nil {
+		return q.pb, nil
+	}
+
+	// Once the cost is known to be zero there is nothing to pay, so the operator isn't asked to sign a payment.
+	if q.pbHeader.ResponseType == services.ResponseType_ANSWER_ONLY && q.queryPayment.tinybar == 0 {
+		q.pbHeader.Payment = nil
+		return q.pb, nil
+	}
+
+	tx, err := q.generatePayments(q.client, q.queryPayment)
+	if err != nil {
+		return q.pb, err
 	}
+	q.pbHeader.Payment = tx
 
-	return q.pb
+	return q.pb, nil
 }
 
 func (q *Query) mapResponse(response interface{}, _ AccountID, _ interface{}) (interface{}, error) { // nolint
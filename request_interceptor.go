@@ -0,0 +1,65 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RequestInterceptor answers a request in place of the network. requestType is the name of the transaction or
+// query, such as "TransferTransaction", and request is its *services.Transaction or *services.Query. It returns
+// the *services.TransactionResponse or *services.Response the node would have sent, or an error to simulate a
+// failed gRPC call.
+type RequestInterceptor = _RequestInterceptor
+
+// SetRequestInterceptor makes every request this client executes go to interceptor instead of a node, so
+// transactions and queries can be tested without a server. Pass nil to send requests to the network again.
+// Only available when building with the unit or all tag.
+func (client *Client) SetRequestInterceptor(interceptor RequestInterceptor) *Client {
+	client.requestInterceptor = interceptor
+	return client
+}
+
+// NewCannedResponseInterceptor returns a RequestInterceptor which answers each request type with the next of
+// its responses, in order. A response is either the proto to return or an error. Requests of a type with no
+// responses left fail.
+func NewCannedResponseInterceptor(responses map[string][]interface{}) RequestInterceptor {
+	var lock sync.Mutex
+	return func(requestType string, _ interface{}) (interface{}, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		remaining := responses[requestType]
+		if len(remaining) == 0 {
+			return nil, fmt.Errorf("no canned response left for %s", requestType)
+		}
+		responses[requestType] = remaining[1:]
+
+		if err, ok := remaining[0].(error); ok {
+			return nil, err
+		}
+		return remaining[0], nil
+	}
+}
@@ -0,0 +1,75 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitRequestInterceptorRetriesBusyTransfer(t *testing.T) {
+	t.Parallel()
+
+	operatorKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	calls := 0
+	canned := NewCannedResponseInterceptor(map[string][]interface{}{
+		"TransferTransaction": {
+			&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY},
+			&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		},
+	})
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}}).
+		SetRequestInterceptor(func(requestType string, request interface{}) (interface{}, error) {
+			calls++
+			_, ok := request.(*services.Transaction)
+			assert.True(t, ok)
+			return canned(requestType, request)
+		})
+	client.SetOperator(AccountID{Account: 2}, operatorKey)
+	client.SetMinBackoff(time.Millisecond)
+	client.SetMaxBackoff(10 * time.Millisecond)
+
+	resp, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, AccountID{Account: 3}, resp.NodeID)
+	assert.Equal(t, 2, calls)
+
+	_, err = NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, NewHbar(1)).
+		SetMaxRetry(1).
+		Execute(client)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no canned response left for TransferTransaction")
+}
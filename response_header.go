@@ -0,0 +1,87 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// ResponseType is the kind of answer requested from, and repeated back by, a node for a query.
+type ResponseType int32
+
+const (
+	ResponseTypeAnswerOnly           ResponseType = ResponseType(services.ResponseType_ANSWER_ONLY)
+	ResponseTypeAnswerStateProof     ResponseType = ResponseType(services.ResponseType_ANSWER_STATE_PROOF)
+	ResponseTypeCostAnswer           ResponseType = ResponseType(services.ResponseType_COST_ANSWER)
+	ResponseTypeCostAnswerStateProof ResponseType = ResponseType(services.ResponseType_COST_ANSWER_STATE_PROOF)
+)
+
+// String returns a string representation of the ResponseType
+func (responseType ResponseType) String() string {
+	switch responseType {
+	case ResponseTypeAnswerOnly:
+		return "ANSWER_ONLY"
+	case ResponseTypeAnswerStateProof:
+		return "ANSWER_STATE_PROOF"
+	case ResponseTypeCostAnswer:
+		return "COST_ANSWER"
+	case ResponseTypeCostAnswerStateProof:
+		return "COST_ANSWER_STATE_PROOF"
+	}
+
+	panic(fmt.Sprintf("unreachable: ResponseType.String() switch statement is non-exhaustive. ResponseType: %v", int32(responseType)))
+}
+
+// ResponseHeader is the header a node sends back with every query response.
+type ResponseHeader struct {
+	// The precheck status the node returned for the query
+	Status Status
+	// The requested response type, repeated back by the node
+	ResponseType ResponseType
+	// The fee that would be charged to get the requested information, if a cost was requested
+	Cost Hbar
+	// The state proof for the requested information, if a state proof was requested and is available
+	StateProof []byte
+}
+
+func _ResponseHeaderFromProtobuf(pb *services.ResponseHeader) ResponseHeader {
+	if pb == nil {
+		return ResponseHeader{}
+	}
+
+	return ResponseHeader{
+		Status:       Status(pb.NodeTransactionPrecheckCode),
+		ResponseType: ResponseType(pb.ResponseType),
+		Cost:         HbarFromTinybar(int64(pb.Cost)),
+		StateProof:   pb.StateProof,
+	}
+}
+
+func (header ResponseHeader) _ToProtobuf() *services.ResponseHeader {
+	return &services.ResponseHeader{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum(header.Status),
+		ResponseType:                services.ResponseType(header.ResponseType),
+		Cost:                        uint64(header.Cost.AsTinybar()),
+		StateProof:                  header.StateProof,
+	}
+}
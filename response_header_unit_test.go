@@ -0,0 +1,152 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// A response header as sent by a node for a state proof query: ANSWER_STATE_PROOF, a cost of 25 tinybar and the
+// state proof bytes "proof".
+const recordedResponseHeader = "10011819220570726f6f66"
+
+func TestUnitResponseHeaderFromProtobuf(t *testing.T) {
+	t.Parallel()
+
+	data, err := hex.DecodeString(recordedResponseHeader)
+	require.NoError(t, err)
+	pb := services.ResponseHeader{}
+	require.NoError(t, protobuf.Unmarshal(data, &pb))
+
+	header := _ResponseHeaderFromProtobuf(&pb)
+	require.Equal(t, StatusOk, header.Status)
+	require.Equal(t, ResponseTypeAnswerStateProof, header.ResponseType)
+	require.Equal(t, "ANSWER_STATE_PROOF", header.ResponseType.String())
+	require.Equal(t, HbarFromTinybar(25), header.Cost)
+	require.Equal(t, []byte("proof"), header.StateProof)
+
+	roundTrip, err := protobuf.Marshal(header._ToProtobuf())
+	require.NoError(t, err)
+	require.Equal(t, recordedResponseHeader, hex.EncodeToString(roundTrip))
+
+	require.Equal(t, ResponseHeader{}, _ResponseHeaderFromProtobuf(nil))
+}
+
+func TestUnitQueryGetResponseHeader(t *testing.T) {
+	t.Parallel()
+
+	data, err := hex.DecodeString(recordedResponseHeader)
+	require.NoError(t, err)
+	pb := services.ResponseHeader{}
+	require.NoError(t, protobuf.Unmarshal(data, &pb))
+
+	key, err := PrivateKeyFromStringEd25519(mockPrivateKey)
+	require.NoError(t, err)
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_CryptoGetInfo{
+				CryptoGetInfo: &services.CryptoGetInfoResponse{
+					Header: &pb,
+					AccountInfo: &services.CryptoGetInfoResponse_AccountInfo{
+						AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1234}},
+						Key:       key.PublicKey()._ToProtoKey(),
+					},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	query := NewAccountInfoQuery().
+		SetAccountID(AccountID{Account: 1234}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetQueryPayment(HbarFromTinybar(25))
+	require.Equal(t, ResponseHeader{}, query.GetResponseHeader())
+
+	_, err = query.Execute(client)
+	require.NoError(t, err)
+
+	header := query.GetResponseHeader()
+	require.Equal(t, ResponseTypeAnswerStateProof, header.ResponseType)
+	require.Equal(t, HbarFromTinybar(25), header.Cost)
+	require.Equal(t, []byte("proof"), header.StateProof)
+}
+
+func TestUnitQueryRequestStateProof(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyFromStringEd25519(mockPrivateKey)
+	require.NoError(t, err)
+
+	respond := func(expected services.ResponseType, stateProof []byte) func(request *services.Query) *services.Response {
+		return func(request *services.Query) *services.Response {
+			require.Equal(t, expected, request.GetCryptoGetInfo().GetHeader().GetResponseType())
+
+			return &services.Response{
+				Response: &services.Response_CryptoGetInfo{
+					CryptoGetInfo: &services.CryptoGetInfoResponse{
+						Header: &services.ResponseHeader{ResponseType: expected, Cost: 25, StateProof: stateProof},
+						AccountInfo: &services.CryptoGetInfoResponse_AccountInfo{
+							AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1234}},
+							Key:       key.PublicKey()._ToProtoKey(),
+						},
+					},
+				},
+			}
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		respond(services.ResponseType_COST_ANSWER_STATE_PROOF, nil),
+		respond(services.ResponseType_ANSWER_STATE_PROOF, []byte("proof")),
+		respond(services.ResponseType_ANSWER_STATE_PROOF, nil),
+	}})
+	defer server.Close()
+
+	query := NewAccountInfoQuery().
+		SetAccountID(AccountID{Account: 1234}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetRequestStateProof(true)
+	require.True(t, query.GetRequestStateProof())
+
+	cost, err := query.GetCost(client)
+	require.NoError(t, err)
+	require.Equal(t, HbarFromTinybar(25), cost)
+
+	_, err = query.SetQueryPayment(cost).Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, ResponseTypeAnswerStateProof, query.GetResponseHeader().ResponseType)
+	require.Equal(t, []byte("proof"), query.GetResponseHeader().StateProof)
+
+	_, err = query.Execute(client)
+	require.ErrorIs(t, err, ErrStateProofNotReturned)
+}
@@ -57,11 +57,16 @@ func NewScheduleCreateTransaction() *ScheduleCreateTransaction {
 	return &tx
 }
 
+// maxScheduleExpirationLifetime is how far past the transaction's valid start the network accepts a schedule's
+// expiration time (ledger.schedule.maxExpirationFutureSeconds).
+const maxScheduleExpirationLifetime = 62 * 24 * time.Hour
+
 func _ScheduleCreateTransactionFromProtobuf(tx Transaction, pb *services.TransactionBody) *ScheduleCreateTransaction {
 	key, _ := _KeyFromProtobuf(pb.GetScheduleCreate().GetAdminKey())
-	var expirationTime time.Time
+	var expirationTime *time.Time
 	if pb.GetScheduleCreate().GetExpirationTime() != nil {
-		expirationTime = _TimeFromProtobuf(pb.GetScheduleCreate().GetExpirationTime())
+		expiration := _TimeFromProtobuf(pb.GetScheduleCreate().GetExpirationTime())
+		expirationTime = &expiration
 	}
 
 	return &ScheduleCreateTransaction{
@@ -70,7 +75,7 @@ func _ScheduleCreateTransactionFromProtobuf(tx Transaction, pb *services.Transac
 		adminKey:        key,
 		schedulableBody: pb.GetScheduleCreate().GetScheduledTransactionBody(),
 		memo:            pb.GetScheduleCreate().GetMemo(),
-		expirationTime:  &expirationTime,
+		expirationTime:  expirationTime,
 		waitForExpiry:   pb.GetScheduleCreate().WaitForExpiry,
 	}
 }
@@ -103,7 +108,8 @@ func (tx *ScheduleCreateTransaction) SetAdminKey(key Key) *ScheduleCreateTransac
 }
 
 // SetExpirationTime Sets an optional timestamp for specifying when the transaction should be evaluated for execution and then expire.
-// Defaults to 30 minutes after the transaction's consensus timestamp.
+// Defaults to 30 minutes after the transaction's consensus timestamp. It must be after the transaction's valid start
+// and at most 62 days later, the network's limit, or freezing fails with ErrScheduleExpirationOutOfRange.
 func (tx *ScheduleCreateTransaction) SetExpirationTime(time time.Time) *ScheduleCreateTransaction {
 	tx._RequireNotFrozen()
 	tx.expirationTime = &time
@@ -122,7 +128,8 @@ func (tx *ScheduleCreateTransaction) GetExpirationTime() time.Time {
 
 // SetWaitForExpiry
 // When set to true, the transaction will be evaluated for execution at expiration_time instead
-// of when all required signatures are received.
+// of when all required signatures are received. It requires SetExpirationTime, otherwise freezing fails with
+// ErrWaitForExpiryWithoutExpirationTime.
 // When set to false, the transaction will execute immediately after sufficient signatures are received
 // to sign the contained transaction. During the initial ScheduleCreate transaction or via ScheduleSign transactions.
 // Defaults to false.
@@ -171,6 +178,10 @@ func (tx *ScheduleCreateTransaction) GetScheduleMemo() string {
 func (tx *ScheduleCreateTransaction) SetScheduledTransaction(scheduledTx ITransaction) (*ScheduleCreateTransaction, error) {
 	tx._RequireNotFrozen()
 
+	if transaction, ok := scheduledTx.(TransactionInterface); ok && !transaction.IsSchedulable() {
+		return tx, ErrTransactionNotSchedulable{Transaction: transaction.getName()}
+	}
+
 	scheduled, err := scheduledTx._ConstructScheduleProtobuf()
 	if err != nil {
 		return tx, err
@@ -207,6 +218,19 @@ func (tx *ScheduleCreateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *ScheduleCreateTransaction) SignWithSigner(signer Signer) *ScheduleCreateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this ScheduleCreateTransaction.
+func (tx *ScheduleCreateTransaction) SetSigningKeys(keys []PublicKey) *ScheduleCreateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *ScheduleCreateTransaction) AddSignature(publicKey PublicKey, signature []byte) *ScheduleCreateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -286,6 +310,12 @@ func (tx *ScheduleCreateTransaction) SetMaxBackoff(max time.Duration) *ScheduleC
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *ScheduleCreateTransaction) SetSingleNode(singleNode bool) *ScheduleCreateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *ScheduleCreateTransaction) SetMinBackoff(min time.Duration) *ScheduleCreateTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -311,6 +341,28 @@ func (tx *ScheduleCreateTransaction) getName() string {
 	return "ScheduleCreateTransaction"
 }
 
+func (tx *ScheduleCreateTransaction) validateBeforeFreeze(client *Client) error {
+	if tx.waitForExpiry && tx.expirationTime == nil {
+		return ErrWaitForExpiryWithoutExpirationTime
+	}
+
+	if tx.expirationTime != nil {
+		validStart := time.Now()
+		if tx.transactionID.ValidStart != nil {
+			validStart = *tx.transactionID.ValidStart
+		}
+		if !tx.expirationTime.After(validStart) || tx.expirationTime.Sub(validStart) > maxScheduleExpirationLifetime {
+			return ErrScheduleExpirationOutOfRange{
+				ExpirationTime: *tx.expirationTime,
+				ValidStart:     validStart,
+				MaxLifetime:    maxScheduleExpirationLifetime,
+			}
+		}
+	}
+
+	return nil
+}
+
 func (tx *ScheduleCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -341,6 +393,11 @@ func (tx *ScheduleCreateTransaction) buildScheduled() (*services.SchedulableTran
 	return nil, errors.New("cannot schedule `ScheduleCreateTransaction`")
 }
 
+// IsSchedulable returns false, as a ScheduleCreateTransaction can't be scheduled.
+func (tx *ScheduleCreateTransaction) IsSchedulable() bool {
+	return false
+}
+
 func (tx *ScheduleCreateTransaction) buildProtoBody() *services.ScheduleCreateTransactionBody {
 	body := &services.ScheduleCreateTransactionBody{
 		Memo:          tx.memo,
@@ -318,7 +318,7 @@ func TestUnitScheduleCreateTransactionCoverage(t *testing.T) {
 		SetAdminKey(newKey).
 		SetScheduleMemo("no").
 		SetPayerAccountID(account).
-		SetExpirationTime(time.Unix(3, 23)).
+		SetExpirationTime(time.Now().Add(time.Hour)).
 		SetWaitForExpiry(true).
 		SetScheduledTransaction(accountCreate)
 	require.NoError(t, err)
@@ -535,3 +535,78 @@ func TestUnitScheduleDeleteTransactionMock(t *testing.T) {
 	_, err = freez.Sign(newKey).Execute(client)
 	require.NoError(t, err)
 }
+
+func TestUnitScheduleCreateTransactionIsSchedulable(t *testing.T) {
+	t.Parallel()
+
+	transfer := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1))
+	require.True(t, transfer.IsSchedulable())
+
+	scheduled, err := transfer.Schedule()
+	require.NoError(t, err)
+	require.NotNil(t, scheduled)
+
+	freeze := NewFreezeTransaction().
+		SetFreezeType(FreezeTypeFreezeOnly)
+	require.False(t, freeze.IsSchedulable())
+	require.False(t, NewScheduleCreateTransaction().IsSchedulable())
+
+	_, err = freeze.Schedule()
+	require.ErrorIs(t, err, ErrTransactionNotSchedulable{Transaction: "FreezeTransaction"})
+
+	_, err = NewScheduleCreateTransaction().SetScheduledTransaction(freeze)
+	require.ErrorIs(t, err, ErrTransactionNotSchedulable{Transaction: "FreezeTransaction"})
+}
+
+func TestUnitScheduleCreateTransactionExpirationSerialization(t *testing.T) {
+	t.Parallel()
+
+	validStart := time.Unix(1694689200, 0)
+	transactionID := NewTransactionIDWithValidStart(AccountID{Account: 1800}, validStart)
+	expiration := validStart.Add(30 * 24 * time.Hour)
+
+	newSchedule := func() *ScheduleCreateTransaction {
+		return NewScheduleCreateTransaction().
+			SetTransactionID(transactionID).
+			SetNodeAccountIDs([]AccountID{{Account: 3}})
+	}
+
+	tx, err := newSchedule().
+		SetExpirationTime(expiration).
+		SetWaitForExpiry(true).
+		Freeze()
+	require.NoError(t, err)
+	body := tx.build().GetScheduleCreate()
+	require.Equal(t, expiration.Unix(), body.GetExpirationTime().GetSeconds())
+	require.True(t, body.GetWaitForExpiry())
+
+	data, err := tx.ToBytes()
+	require.NoError(t, err)
+	decoded, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+	schedule := decoded.(ScheduleCreateTransaction)
+	require.Equal(t, expiration.Unix(), schedule.GetExpirationTime().Unix())
+	require.True(t, schedule.GetWaitForExpiry())
+
+	tx, err = newSchedule().Freeze()
+	require.NoError(t, err)
+	data, err = tx.ToBytes()
+	require.NoError(t, err)
+	decoded, err = TransactionFromBytes(data)
+	require.NoError(t, err)
+	schedule = decoded.(ScheduleCreateTransaction)
+	require.Nil(t, schedule.build().GetScheduleCreate().GetExpirationTime())
+	require.False(t, schedule.GetWaitForExpiry())
+
+	_, err = newSchedule().SetWaitForExpiry(true).Freeze()
+	require.ErrorIs(t, err, ErrWaitForExpiryWithoutExpirationTime)
+
+	var outOfRange ErrScheduleExpirationOutOfRange
+	_, err = newSchedule().SetExpirationTime(validStart.Add(-time.Minute)).Freeze()
+	require.ErrorAs(t, err, &outOfRange)
+	_, err = newSchedule().SetExpirationTime(validStart.Add(63 * 24 * time.Hour)).Freeze()
+	require.ErrorAs(t, err, &outOfRange)
+	require.Equal(t, 62*24*time.Hour, outOfRange.MaxLifetime)
+}
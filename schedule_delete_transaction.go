@@ -95,6 +95,19 @@ func (tx *ScheduleDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *ScheduleDeleteTransaction) SignWithSigner(signer Signer) *ScheduleDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this ScheduleDeleteTransaction.
+func (tx *ScheduleDeleteTransaction) SetSigningKeys(keys []PublicKey) *ScheduleDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *ScheduleDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *ScheduleDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -174,6 +187,12 @@ func (tx *ScheduleDeleteTransaction) SetMaxBackoff(max time.Duration) *ScheduleD
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *ScheduleDeleteTransaction) SetSingleNode(singleNode bool) *ScheduleDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *ScheduleDeleteTransaction) SetMinBackoff(min time.Duration) *ScheduleDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -67,7 +67,7 @@ func (id *ScheduleID) ValidateChecksum(client *Client) error {
 			return err
 		}
 		if id.checksum == nil {
-			return errChecksumMissing
+			return ErrChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
 			networkName := NetworkNameOther
@@ -98,18 +98,12 @@ func (id ScheduleID) String() string {
 // ToStringWithChecksum returns the string representation of an ScheduleID in
 // `Shard.Realm.Account-checksum` (for example "0.0.3-laujm")
 func (id ScheduleID) ToStringWithChecksum(client Client) (string, error) {
-	if client.GetNetworkName() == nil && client.GetLedgerID() == nil {
-		return "", errNetworkNameMissing
-	}
-	var checksum _ParseAddressResult
-	var err error
-	if client.network.ledgerID != nil {
-		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Schedule))
-	}
+	checksum, err := _ChecksumForID(&client, id.Shard, id.Realm, id.Schedule)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Schedule, checksum.correctChecksum), nil
+
+	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Schedule, checksum), nil
 }
 
 func (id ScheduleID) _ToProtobuf() *services.ScheduleID {
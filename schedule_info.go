@@ -25,6 +25,7 @@ import (
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 type ScheduleInfo struct {
@@ -148,6 +149,21 @@ func (scheduleInfo *ScheduleInfo) _ToProtobuf() *services.ScheduleInfo { // noli
 	return info
 }
 
+// GetScheduledTransactionBody returns the serialized SchedulableTransactionBody of the scheduled transaction,
+// so it can be hashed or decoded independently, e.g. with TransferTransactionFromSchedulableBodyBytes.
+func (scheduleInfo *ScheduleInfo) GetScheduledTransactionBody() []byte {
+	if scheduleInfo.scheduledTransactionBody == nil {
+		return []byte{}
+	}
+
+	data, err := protobuf.Marshal(scheduleInfo.scheduledTransactionBody)
+	if err != nil {
+		return []byte{}
+	}
+
+	return data
+}
+
 // GetScheduledTransaction returns the scheduled transaction associated with this schedule
 func (scheduleInfo *ScheduleInfo) GetScheduledTransaction() (ITransaction, error) { // nolint
 	pb := scheduleInfo.scheduledTransactionBody
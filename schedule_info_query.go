@@ -82,6 +82,19 @@ func (q *ScheduleInfoQuery) SetMaxQueryPayment(maxPayment Hbar) *ScheduleInfoQue
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *ScheduleInfoQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *ScheduleInfoQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *ScheduleInfoQuery) SetRequestStateProof(request bool) *ScheduleInfoQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *ScheduleInfoQuery) SetQueryPayment(paymentAmount Hbar) *ScheduleInfoQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -107,6 +120,12 @@ func (q *ScheduleInfoQuery) SetMaxBackoff(max time.Duration) *ScheduleInfoQuery
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *ScheduleInfoQuery) SetSingleNode(singleNode bool) *ScheduleInfoQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *ScheduleInfoQuery) SetMinBackoff(min time.Duration) *ScheduleInfoQuery {
 	q.Query.SetMinBackoff(min)
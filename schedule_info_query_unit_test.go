@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 func TestUnitScheduleInfoQueryValidate(t *testing.T) {
@@ -216,3 +217,59 @@ func TestUnitScheduleInfoQueryMock(t *testing.T) {
 	_, err = query.Execute(client)
 	require.NoError(t, err)
 }
+
+func TestUnitScheduleInfoQueryScheduledTransactionBody(t *testing.T) {
+	t.Parallel()
+
+	body := &services.SchedulableTransactionBody{
+		TransactionFee: 100000000,
+		Memo:           "audit",
+		Data: &services.SchedulableTransactionBody_CryptoTransfer{
+			CryptoTransfer: &services.CryptoTransferTransactionBody{
+				Transfers: &services.TransferList{
+					AccountAmounts: []*services.AccountAmount{
+						{AccountID: AccountID{Account: 1800}._ToProtobuf(), Amount: -100},
+						{AccountID: AccountID{Account: 1234}._ToProtobuf(), Amount: 100},
+					},
+				},
+			},
+		},
+	}
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_ScheduleGetInfo{
+				ScheduleGetInfo: &services.ScheduleGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					ScheduleInfo: &services.ScheduleInfo{
+						ScheduleID:               ScheduleID{Schedule: 3}._ToProtobuf(),
+						ScheduledTransactionBody: body,
+					},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	info, err := NewScheduleInfoQuery().
+		SetScheduleID(ScheduleID{Schedule: 3}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetQueryPayment(NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	decoded := services.SchedulableTransactionBody{}
+	require.NoError(t, protobuf.Unmarshal(info.GetScheduledTransactionBody(), &decoded))
+	require.IsType(t, &services.SchedulableTransactionBody_CryptoTransfer{}, decoded.Data)
+	require.True(t, protobuf.Equal(body, &decoded))
+
+	transfer, err := TransferTransactionFromSchedulableBodyBytes(info.GetScheduledTransactionBody())
+	require.NoError(t, err)
+	require.Equal(t, map[AccountID]Hbar{
+		{Account: 1800}: HbarFromTinybar(-100),
+		{Account: 1234}: HbarFromTinybar(100),
+	}, transfer.GetHbarTransfers())
+
+	require.Empty(t, (&ScheduleInfo{}).GetScheduledTransactionBody())
+}
@@ -108,6 +108,19 @@ func (tx *ScheduleSignTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *ScheduleSignTransaction) SignWithSigner(signer Signer) *ScheduleSignTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this ScheduleSignTransaction.
+func (tx *ScheduleSignTransaction) SetSigningKeys(keys []PublicKey) *ScheduleSignTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *ScheduleSignTransaction) AddSignature(publicKey PublicKey, signature []byte) *ScheduleSignTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -187,6 +200,12 @@ func (tx *ScheduleSignTransaction) SetMaxBackoff(max time.Duration) *ScheduleSig
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *ScheduleSignTransaction) SetSingleNode(singleNode bool) *ScheduleSignTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *ScheduleSignTransaction) SetMinBackoff(min time.Duration) *ScheduleSignTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -242,6 +261,11 @@ func (tx *ScheduleSignTransaction) buildScheduled() (*services.SchedulableTransa
 	return nil, errors.New("cannot schedule `ScheduleSignTransaction")
 }
 
+// IsSchedulable returns false, as a ScheduleSignTransaction can't be scheduled.
+func (tx *ScheduleSignTransaction) IsSchedulable() bool {
+	return false
+}
+
 func (tx *ScheduleSignTransaction) buildProtoBody() *services.ScheduleSignTransactionBody {
 	body := &services.ScheduleSignTransactionBody{}
 	if tx.scheduleID != nil {
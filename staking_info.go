@@ -102,7 +102,7 @@ func (stakingInfo *StakingInfo) ToBytes() []byte {
 // StakingInfoFromBytes returns a StakingInfo object from a raw byte array
 func StakingInfoFromBytes(data []byte) (StakingInfo, error) {
 	if data == nil {
-		return StakingInfo{}, errByteArrayNull
+		return StakingInfo{}, ErrByteArrayNull
 	}
 	pb := services.StakingInfo{}
 	err := protobuf.Unmarshal(data, &pb)
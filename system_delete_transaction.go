@@ -140,6 +140,19 @@ func (tx *SystemDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *SystemDeleteTransaction) SignWithSigner(signer Signer) *SystemDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this SystemDeleteTransaction.
+func (tx *SystemDeleteTransaction) SetSigningKeys(keys []PublicKey) *SystemDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *SystemDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *SystemDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -219,6 +232,12 @@ func (tx *SystemDeleteTransaction) SetMaxBackoff(max time.Duration) *SystemDelet
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *SystemDeleteTransaction) SetSingleNode(singleNode bool) *SystemDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *SystemDeleteTransaction) SetMinBackoff(min time.Duration) *SystemDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
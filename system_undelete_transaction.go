@@ -112,6 +112,19 @@ func (tx *SystemUndeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *SystemUndeleteTransaction) SignWithSigner(signer Signer) *SystemUndeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this SystemUndeleteTransaction.
+func (tx *SystemUndeleteTransaction) SetSigningKeys(keys []PublicKey) *SystemUndeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *SystemUndeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *SystemUndeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -191,6 +204,12 @@ func (tx *SystemUndeleteTransaction) SetMaxBackoff(max time.Duration) *SystemUnd
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *SystemUndeleteTransaction) SetSingleNode(singleNode bool) *SystemUndeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *SystemUndeleteTransaction) SetMinBackoff(min time.Duration) *SystemUndeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
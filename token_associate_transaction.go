@@ -164,6 +164,19 @@ func (tx *TokenAssociateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenAssociateTransaction) SignWithSigner(signer Signer) *TokenAssociateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenAssociateTransaction.
+func (tx *TokenAssociateTransaction) SetSigningKeys(keys []PublicKey) *TokenAssociateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenAssociateTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenAssociateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -243,6 +256,12 @@ func (tx *TokenAssociateTransaction) SetMaxBackoff(max time.Duration) *TokenAsso
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenAssociateTransaction) SetSingleNode(singleNode bool) *TokenAssociateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenAssociateTransaction) SetMinBackoff(min time.Duration) *TokenAssociateTransaction {
 	tx.Transaction.SetMinBackoff(min)
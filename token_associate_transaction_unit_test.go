@@ -116,8 +116,9 @@ func TestUnitTokenAssociateTransactionMock(t *testing.T) {
 			require.True(t, verified)
 		}
 
-		require.Equal(t, bytes.Compare(sigMap.SigPair[1].PubKeyPrefix, key.PublicKey().BytesRaw()), 0)
-		require.Equal(t, bytes.Compare(sigMap.SigPair[0].PubKeyPrefix, newKey.PublicKey().BytesRaw()), 0)
+		require.Len(t, sigMap.SigPair, 2)
+		require.ElementsMatch(t, [][]byte{key.PublicKey().BytesRaw(), newKey.PublicKey().BytesRaw()}, [][]byte{sigMap.SigPair[0].PubKeyPrefix, sigMap.SigPair[1].PubKeyPrefix})
+		require.Negative(t, bytes.Compare(sigMap.SigPair[0].PubKeyPrefix, sigMap.SigPair[1].PubKeyPrefix))
 
 		if bod, ok := transactionBody.Data.(*services.TransactionBody_TokenAssociate); ok {
 			require.Equal(t, bod.TokenAssociate.Account.GetAccountNum(), int64(123))
@@ -72,7 +72,7 @@ func (association *TokenAssociation) ToBytes() []byte {
 // TokenAssociationFromBytes returns a TokenAssociation from a raw protobuf byte array
 func TokenAssociationFromBytes(data []byte) (TokenAssociation, error) {
 	if data == nil {
-		return TokenAssociation{}, errByteArrayNull
+		return TokenAssociation{}, ErrByteArrayNull
 	}
 	pb := services.TokenAssociation{}
 	err := protobuf.Unmarshal(data, &pb)
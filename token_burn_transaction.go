@@ -154,6 +154,19 @@ func (tx *TokenBurnTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenBurnTransaction) SignWithSigner(signer Signer) *TokenBurnTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenBurnTransaction.
+func (tx *TokenBurnTransaction) SetSigningKeys(keys []PublicKey) *TokenBurnTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenBurnTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenBurnTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -233,6 +246,12 @@ func (tx *TokenBurnTransaction) SetMaxBackoff(max time.Duration) *TokenBurnTrans
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenBurnTransaction) SetSingleNode(singleNode bool) *TokenBurnTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenBurnTransaction) SetMinBackoff(min time.Duration) *TokenBurnTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -457,7 +457,8 @@ func (tx *TokenCreateTransaction) GetExpirationTime() time.Time {
 	return time.Time{}
 }
 
-// An account which will be automatically charged to renew the token's expiration, at autoRenewPeriod interval
+// An account which will be automatically charged to renew the token's expiration, at autoRenewPeriod interval.
+// An auto-renew period must be set alongside it, and the auto-renew account must sign this transaction.
 func (tx *TokenCreateTransaction) SetAutoRenewAccount(autoRenewAccountID AccountID) *TokenCreateTransaction {
 	tx._RequireNotFrozen()
 	tx.autoRenewAccountID = &autoRenewAccountID
@@ -514,6 +515,19 @@ func (tx *TokenCreateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenCreateTransaction) SignWithSigner(signer Signer) *TokenCreateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenCreateTransaction.
+func (tx *TokenCreateTransaction) SetSigningKeys(keys []PublicKey) *TokenCreateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenCreateTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenCreateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -593,6 +607,12 @@ func (tx *TokenCreateTransaction) SetMaxBackoff(max time.Duration) *TokenCreateT
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenCreateTransaction) SetSingleNode(singleNode bool) *TokenCreateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenCreateTransaction) SetMinBackoff(min time.Duration) *TokenCreateTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -618,6 +638,14 @@ func (tx *TokenCreateTransaction) getName() string {
 	return "TokenCreateTransaction"
 }
 
+func (tx *TokenCreateTransaction) validateBeforeFreeze(client *Client) error {
+	if tx.autoRenewAccountID != nil && (tx.autoRenewPeriod == nil || *tx.autoRenewPeriod <= 0) {
+		return ErrAutoRenewPeriodNotSet
+	}
+
+	return nil
+}
+
 func (tx *TokenCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -354,3 +354,49 @@ func TestUnitTokenCreateAutoRenewAccountSetting(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, autoRenewAccount, frozenTx.GetAutoRenewAccount())
 }
+
+func TestUnitTokenCreateTransactionAutoRenewAccountSerialization(t *testing.T) {
+	t.Parallel()
+
+	nodeAccountID := []AccountID{{Account: 10}}
+	transactionID := TransactionIDGenerate(AccountID{Account: 324})
+	autoRenewAccount := AccountID{Account: 5}
+
+	tokenCreate, err := NewTokenCreateTransaction().
+		SetTransactionID(transactionID).
+		SetNodeAccountIDs(nodeAccountID).
+		SetTokenName("ffff").
+		SetTokenSymbol("F").
+		SetAutoRenewAccount(autoRenewAccount).
+		SetAutoRenewPeriod(24 * time.Hour).
+		Freeze()
+	require.NoError(t, err)
+
+	body := tokenCreate.build().GetTokenCreation()
+	require.Equal(t, autoRenewAccount._ToProtobuf().String(), body.GetAutoRenewAccount().String())
+	require.Equal(t, int64(86400), body.GetAutoRenewPeriod().GetSeconds())
+
+	transactionBytes, err := tokenCreate.ToBytes()
+	require.NoError(t, err)
+
+	txParsed, err := TransactionFromBytes(transactionBytes)
+	require.NoError(t, err)
+
+	result, ok := txParsed.(TokenCreateTransaction)
+	require.True(t, ok)
+
+	require.Equal(t, autoRenewAccount, result.GetAutoRenewAccount())
+	require.Equal(t, 24*time.Hour, result.GetAutoRenewPeriod())
+}
+
+func TestUnitTokenCreateTransactionAutoRenewAccountRequiresPeriod(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTokenCreateTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 324})).
+		SetNodeAccountIDs([]AccountID{{Account: 10}}).
+		SetAutoRenewAccount(AccountID{Account: 5}).
+		SetExpirationTime(time.Now().Add(24 * time.Hour)).
+		Freeze()
+	require.ErrorIs(t, err, ErrAutoRenewPeriodNotSet)
+}
@@ -103,6 +103,19 @@ func (tx *TokenDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenDeleteTransaction) SignWithSigner(signer Signer) *TokenDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenDeleteTransaction.
+func (tx *TokenDeleteTransaction) SetSigningKeys(keys []PublicKey) *TokenDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -182,6 +195,12 @@ func (tx *TokenDeleteTransaction) SetMaxBackoff(max time.Duration) *TokenDeleteT
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenDeleteTransaction) SetSingleNode(singleNode bool) *TokenDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenDeleteTransaction) SetMinBackoff(min time.Duration) *TokenDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -158,6 +158,19 @@ func (tx *TokenDissociateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenDissociateTransaction) SignWithSigner(signer Signer) *TokenDissociateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenDissociateTransaction.
+func (tx *TokenDissociateTransaction) SetSigningKeys(keys []PublicKey) *TokenDissociateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenDissociateTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenDissociateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -237,6 +250,12 @@ func (tx *TokenDissociateTransaction) SetMaxBackoff(max time.Duration) *TokenDis
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenDissociateTransaction) SetSingleNode(singleNode bool) *TokenDissociateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenDissociateTransaction) SetMinBackoff(min time.Duration) *TokenDissociateTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -131,6 +131,19 @@ func (tx *TokenFeeScheduleUpdateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenFeeScheduleUpdateTransaction) SignWithSigner(signer Signer) *TokenFeeScheduleUpdateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenFeeScheduleUpdateTransaction.
+func (tx *TokenFeeScheduleUpdateTransaction) SetSigningKeys(keys []PublicKey) *TokenFeeScheduleUpdateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenFeeScheduleUpdateTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenFeeScheduleUpdateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -210,6 +223,12 @@ func (tx *TokenFeeScheduleUpdateTransaction) SetMaxBackoff(max time.Duration) *T
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenFeeScheduleUpdateTransaction) SetSingleNode(singleNode bool) *TokenFeeScheduleUpdateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenFeeScheduleUpdateTransaction) SetMinBackoff(min time.Duration) *TokenFeeScheduleUpdateTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -271,6 +290,11 @@ func (tx *TokenFeeScheduleUpdateTransaction) buildScheduled() (*services.Schedul
 	return nil, errors.New("cannot schedule `TokenFeeScheduleUpdateTransaction")
 }
 
+// IsSchedulable returns false, as a TokenFeeScheduleUpdateTransaction can't be scheduled.
+func (tx *TokenFeeScheduleUpdateTransaction) IsSchedulable() bool {
+	return false
+}
+
 func (tx *TokenFeeScheduleUpdateTransaction) buildProtoBody() *services.TokenFeeScheduleUpdateTransactionBody {
 	body := &services.TokenFeeScheduleUpdateTransactionBody{}
 	if tx.tokenID != nil {
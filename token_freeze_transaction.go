@@ -132,6 +132,19 @@ func (tx *TokenFreezeTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenFreezeTransaction) SignWithSigner(signer Signer) *TokenFreezeTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenFreezeTransaction.
+func (tx *TokenFreezeTransaction) SetSigningKeys(keys []PublicKey) *TokenFreezeTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenFreezeTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenFreezeTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -211,6 +224,12 @@ func (tx *TokenFreezeTransaction) SetMaxBackoff(max time.Duration) *TokenFreezeT
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenFreezeTransaction) SetSingleNode(singleNode bool) *TokenFreezeTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenFreezeTransaction) SetMinBackoff(min time.Duration) *TokenFreezeTransaction {
 	tx.Transaction.SetMinBackoff(min)
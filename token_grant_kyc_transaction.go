@@ -130,6 +130,19 @@ func (tx *TokenGrantKycTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenGrantKycTransaction) SignWithSigner(signer Signer) *TokenGrantKycTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenGrantKycTransaction.
+func (tx *TokenGrantKycTransaction) SetSigningKeys(keys []PublicKey) *TokenGrantKycTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenGrantKycTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenGrantKycTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -209,6 +222,12 @@ func (tx *TokenGrantKycTransaction) SetMaxBackoff(max time.Duration) *TokenGrant
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenGrantKycTransaction) SetSingleNode(singleNode bool) *TokenGrantKycTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenGrantKycTransaction) SetMinBackoff(min time.Duration) *TokenGrantKycTransaction {
 	tx.Transaction.SetMinBackoff(min)
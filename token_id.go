@@ -68,18 +68,12 @@ func (id TokenID) String() string {
 
 // ToStringWithChecksum returns a string representation of the TokenID formatted as `Shard.Realm.TokenID-Checksum` (for example "0.0.3-abcd")
 func (id TokenID) ToStringWithChecksum(client Client) (string, error) {
-	if client.GetNetworkName() == nil && client.GetLedgerID() == nil {
-		return "", errNetworkNameMissing
-	}
-	var checksum _ParseAddressResult
-	var err error
-	if client.network.ledgerID != nil {
-		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Token))
-	}
+	checksum, err := _ChecksumForID(&client, id.Shard, id.Realm, id.Token)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Token, checksum.correctChecksum), nil
+
+	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Token, checksum), nil
 }
 
 // ToBytes returns a byte array representation of the TokenID
@@ -95,7 +89,7 @@ func (id TokenID) ToBytes() []byte {
 // TokenIDFromBytes returns a TokenID from a byte array
 func TokenIDFromBytes(data []byte) (TokenID, error) {
 	if data == nil {
-		return TokenID{}, errByteArrayNull
+		return TokenID{}, ErrByteArrayNull
 	}
 	pb := services.TokenID{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -144,7 +138,7 @@ func (id *TokenID) ValidateChecksum(client *Client) error {
 			return err
 		}
 		if id.checksum == nil {
-			return errChecksumMissing
+			return ErrChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
 			networkName := NetworkNameOther
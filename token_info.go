@@ -384,7 +384,7 @@ func (tokenInfo TokenInfo) ToBytes() []byte {
 // TokenInfoFromBytes returns a TokenInfo struct from a raw protobuf byte array
 func TokenInfoFromBytes(data []byte) (TokenInfo, error) {
 	if data == nil {
-		return TokenInfo{}, errByteArrayNull
+		return TokenInfo{}, ErrByteArrayNull
 	}
 	pb := services.TokenInfo{}
 	err := protobuf.Unmarshal(data, &pb)
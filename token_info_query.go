@@ -21,11 +21,59 @@ package hedera
  */
 
 import (
+	"sync"
 	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 )
 
+// batchTokenInfoConcurrency caps how many TokenInfoQuery requests BatchTokenInfo runs at once.
+const batchTokenInfoConcurrency = 8
+
+// TokenInfoResult is the outcome of fetching one token's info with BatchTokenInfo.
+type TokenInfoResult struct {
+	Info TokenInfo
+	Err  error
+}
+
+// BatchTokenInfo fetches the info of every given token, running up to batchTokenInfoConcurrency queries at once.
+// Each token is fetched with its own TokenInfoQuery, which pays for itself with the client's operator. A token
+// which could not be fetched has its error in its result and doesn't affect the others.
+func BatchTokenInfo(client *Client, tokenIDs []TokenID) map[TokenID]TokenInfoResult {
+	results := make(map[TokenID]TokenInfoResult, len(tokenIDs))
+
+	seen := make(map[TokenID]bool, len(tokenIDs))
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, batchTokenInfoConcurrency)
+	for _, tokenID := range tokenIDs {
+		if seen[tokenID] {
+			continue
+		}
+		seen[tokenID] = true
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(tokenID TokenID) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			info, err := NewTokenInfoQuery().
+				SetTokenID(tokenID).
+				Execute(client)
+
+			lock.Lock()
+			results[tokenID] = TokenInfoResult{Info: info, Err: err}
+			lock.Unlock()
+		}(tokenID)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
 // TokenInfoQuery Used get information about Token instance
 type TokenInfoQuery struct {
 	Query
@@ -84,6 +132,19 @@ func (q *TokenInfoQuery) SetMaxQueryPayment(maxPayment Hbar) *TokenInfoQuery {
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *TokenInfoQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *TokenInfoQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *TokenInfoQuery) SetRequestStateProof(request bool) *TokenInfoQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *TokenInfoQuery) SetQueryPayment(paymentAmount Hbar) *TokenInfoQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -109,6 +170,12 @@ func (q *TokenInfoQuery) SetMaxBackoff(max time.Duration) *TokenInfoQuery {
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *TokenInfoQuery) SetSingleNode(singleNode bool) *TokenInfoQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *TokenInfoQuery) SetMinBackoff(min time.Duration) *TokenInfoQuery {
 	q.Query.SetMinBackoff(min)
@@ -25,6 +25,7 @@ package hedera
 
 import (
 	"encoding/base64"
+	"fmt"
 	"testing"
 	"time"
 
@@ -241,3 +242,69 @@ func TestUnitTokenInfoQueryMock(t *testing.T) {
 	_, err = query.Execute(client)
 	require.NoError(t, err)
 }
+
+func TestUnitBatchTokenInfoMock(t *testing.T) {
+	t.Parallel()
+
+	call := func(request *services.Query) *services.Response {
+		query := request.Query.(*services.Query_TokenGetInfo).TokenGetInfo
+		tokenID := _TokenIDFromProtobuf(query.Token)
+
+		if tokenID.Token == 9 {
+			return &services.Response{
+				Response: &services.Response_TokenGetInfo{
+					TokenGetInfo: &services.TokenGetInfoResponse{
+						Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_INVALID_TOKEN_ID, ResponseType: query.Header.ResponseType},
+					},
+				},
+			}
+		}
+
+		if query.Header.ResponseType == services.ResponseType_COST_ANSWER {
+			return &services.Response{
+				Response: &services.Response_TokenGetInfo{
+					TokenGetInfo: &services.TokenGetInfoResponse{
+						Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER, Cost: 2},
+					},
+				},
+			}
+		}
+
+		return &services.Response{
+			Response: &services.Response_TokenGetInfo{
+				TokenGetInfo: &services.TokenGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY, Cost: 2},
+					TokenInfo: &services.TokenInfo{
+						TokenId:  query.Token,
+						Symbol:   fmt.Sprintf("T%d", tokenID.Token),
+						Decimals: uint32(tokenID.Token),
+					},
+				},
+			},
+		}
+	}
+
+	tokenIDs := []TokenID{{Token: 5}, {Token: 6}, {Token: 7}, {Token: 9}, {Token: 5}}
+	responses := make([]interface{}, 0)
+	for i := 0; i < 2*len(tokenIDs); i++ {
+		responses = append(responses, call)
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+
+	results := BatchTokenInfo(client, tokenIDs)
+	require.Len(t, results, 4)
+
+	for _, tokenID := range []TokenID{{Token: 5}, {Token: 6}, {Token: 7}} {
+		result := results[tokenID]
+		require.NoError(t, result.Err)
+		require.Equal(t, tokenID.String(), result.Info.TokenID.String())
+		require.Equal(t, fmt.Sprintf("T%d", tokenID.Token), result.Info.Symbol)
+		require.Equal(t, uint32(tokenID.Token), result.Info.Decimals)
+	}
+
+	var precheckErr ErrHederaPreCheckStatus
+	require.ErrorAs(t, results[TokenID{Token: 9}].Err, &precheckErr)
+	require.Equal(t, StatusInvalidTokenID, precheckErr.Status)
+}
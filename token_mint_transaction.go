@@ -150,6 +150,19 @@ func (tx *TokenMintTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenMintTransaction) SignWithSigner(signer Signer) *TokenMintTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenMintTransaction.
+func (tx *TokenMintTransaction) SetSigningKeys(keys []PublicKey) *TokenMintTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenMintTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenMintTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -229,6 +242,12 @@ func (tx *TokenMintTransaction) SetMaxBackoff(max time.Duration) *TokenMintTrans
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenMintTransaction) SetSingleNode(singleNode bool) *TokenMintTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenMintTransaction) SetMinBackoff(min time.Duration) *TokenMintTransaction {
 	tx.Transaction.SetMinBackoff(min)
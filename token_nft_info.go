@@ -86,7 +86,7 @@ func (tokenNftInfo *TokenNftInfo) ToBytes() []byte {
 // TokenNftInfoFromBytes returns the TokenNftInfo from a byte array representation
 func TokenNftInfoFromBytes(data []byte) (TokenNftInfo, error) {
 	if data == nil {
-		return TokenNftInfo{}, errByteArrayNull
+		return TokenNftInfo{}, ErrByteArrayNull
 	}
 	pb := services.TokenNftInfo{}
 	err := protobuf.Unmarshal(data, &pb)
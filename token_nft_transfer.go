@@ -82,7 +82,7 @@ func (transfer TokenNftTransfer) ToBytes() []byte {
 // TokenNftTransfersFromBytes returns the TokenNftTransfer from a raw protobuf bytes representation
 func NftTransferFromBytes(data []byte) (TokenNftTransfer, error) {
 	if data == nil {
-		return TokenNftTransfer{}, errByteArrayNull
+		return TokenNftTransfer{}, ErrByteArrayNull
 	}
 	pb := services.NftTransfer{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -107,6 +107,19 @@ func (tx *TokenPauseTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenPauseTransaction) SignWithSigner(signer Signer) *TokenPauseTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenPauseTransaction.
+func (tx *TokenPauseTransaction) SetSigningKeys(keys []PublicKey) *TokenPauseTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenPauseTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenPauseTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -186,6 +199,12 @@ func (tx *TokenPauseTransaction) SetMaxBackoff(max time.Duration) *TokenPauseTra
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenPauseTransaction) SetSingleNode(singleNode bool) *TokenPauseTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenPauseTransaction) SetMinBackoff(min time.Duration) *TokenPauseTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -130,6 +130,19 @@ func (tx *TokenRevokeKycTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenRevokeKycTransaction) SignWithSigner(signer Signer) *TokenRevokeKycTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenRevokeKycTransaction.
+func (tx *TokenRevokeKycTransaction) SetSigningKeys(keys []PublicKey) *TokenRevokeKycTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenRevokeKycTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenRevokeKycTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -209,6 +222,12 @@ func (tx *TokenRevokeKycTransaction) SetMaxBackoff(max time.Duration) *TokenRevo
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenRevokeKycTransaction) SetSingleNode(singleNode bool) *TokenRevokeKycTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenRevokeKycTransaction) SetMinBackoff(min time.Duration) *TokenRevokeKycTransaction {
 	tx.Transaction.SetMinBackoff(min)
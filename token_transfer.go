@@ -84,7 +84,7 @@ func (transfer TokenTransfer) ToBytes() []byte {
 // TokenTransferFromBytes returns a TokenTransfer struct from a protobuf encoded byte array
 func TokenTransferFromBytes(data []byte) (TokenTransfer, error) {
 	if data == nil {
-		return TokenTransfer{}, errByteArrayNull
+		return TokenTransfer{}, ErrByteArrayNull
 	}
 	pb := services.AccountAmount{}
 	err := protobuf.Unmarshal(data, &pb)
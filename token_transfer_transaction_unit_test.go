@@ -136,10 +136,12 @@ func TestUnitTokenTransferTransactionValidate(t *testing.T) {
 	require.NoError(t, err)
 	nftID, err := NftIDFromString("2@0.0.123-esxsf")
 	require.NoError(t, err)
+	receiverID, err := AccountIDFromString("0.0.124-ncuzw")
+	require.NoError(t, err)
 
 	tokenTransfer := NewTransferTransaction().
 		AddTokenTransfer(tokenID, accountID, 1).
-		AddNftTransfer(nftID, accountID, accountID)
+		AddNftTransfer(nftID, accountID, receiverID)
 
 	err = tokenTransfer.validateNetworkOnIDs(client)
 	require.NoError(t, err)
@@ -158,10 +160,12 @@ func TestUnitTokenTransferTransactionValidateWrong(t *testing.T) {
 	require.NoError(t, err)
 	nftID, err := NftIDFromString("2@0.0.123-rmkykd")
 	require.NoError(t, err)
+	receiverID, err := AccountIDFromString("0.0.124-ncuzw")
+	require.NoError(t, err)
 
 	tokenTransfer := NewTransferTransaction().
 		AddTokenTransfer(tokenID, accountID, 1).
-		AddNftTransfer(nftID, accountID, accountID)
+		AddNftTransfer(nftID, accountID, receiverID)
 
 	err = tokenTransfer.validateNetworkOnIDs(client)
 	assert.Error(t, err)
@@ -189,7 +193,7 @@ func TestUnitTransferTransactionGet(t *testing.T) {
 		AddHbarTransfer(accountID, NewHbar(34)).
 		AddTokenTransferWithDecimals(tokenID, accountID, 123, 12).
 		AddTokenTransfer(tokenID, accountID, 123).
-		AddNftTransfer(nftID, accountID, accountID).
+		AddNftTransfer(nftID, accountID, AccountID{Account: 4}).
 		SetMaxTransactionFee(NewHbar(10)).
 		SetTransactionMemo("").
 		SetTransactionValidDuration(60 * time.Second).
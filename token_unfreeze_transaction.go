@@ -132,6 +132,19 @@ func (tx *TokenUnfreezeTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenUnfreezeTransaction) SignWithSigner(signer Signer) *TokenUnfreezeTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenUnfreezeTransaction.
+func (tx *TokenUnfreezeTransaction) SetSigningKeys(keys []PublicKey) *TokenUnfreezeTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenUnfreezeTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenUnfreezeTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -211,6 +224,12 @@ func (tx *TokenUnfreezeTransaction) SetMaxBackoff(max time.Duration) *TokenUnfre
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenUnfreezeTransaction) SetSingleNode(singleNode bool) *TokenUnfreezeTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenUnfreezeTransaction) SetMinBackoff(min time.Duration) *TokenUnfreezeTransaction {
 	tx.Transaction.SetMinBackoff(min)
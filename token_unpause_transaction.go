@@ -105,6 +105,19 @@ func (tx *TokenUnpauseTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenUnpauseTransaction) SignWithSigner(signer Signer) *TokenUnpauseTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenUnpauseTransaction.
+func (tx *TokenUnpauseTransaction) SetSigningKeys(keys []PublicKey) *TokenUnpauseTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenUnpauseTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenUnpauseTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -184,6 +197,12 @@ func (tx *TokenUnpauseTransaction) SetMaxBackoff(max time.Duration) *TokenUnpaus
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenUnpauseTransaction) SetSingleNode(singleNode bool) *TokenUnpauseTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenUnpauseTransaction) SetMinBackoff(min time.Duration) *TokenUnpauseTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -91,6 +91,19 @@ func (tx *TokenUpdateNfts) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenUpdateNfts) SignWithSigner(signer Signer) *TokenUpdateNfts {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenUpdateNfts.
+func (tx *TokenUpdateNfts) SetSigningKeys(keys []PublicKey) *TokenUpdateNfts {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenUpdateNfts) AddSignature(publicKey PublicKey, signature []byte) *TokenUpdateNfts {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -170,6 +183,12 @@ func (tx *TokenUpdateNfts) SetMaxBackoff(max time.Duration) *TokenUpdateNfts {
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenUpdateNfts) SetSingleNode(singleNode bool) *TokenUpdateNfts {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenUpdateNfts) SetMinBackoff(min time.Duration) *TokenUpdateNfts {
 	tx.Transaction.SetMinBackoff(min)
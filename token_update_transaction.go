@@ -407,6 +407,19 @@ func (tx *TokenUpdateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenUpdateTransaction) SignWithSigner(signer Signer) *TokenUpdateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenUpdateTransaction.
+func (tx *TokenUpdateTransaction) SetSigningKeys(keys []PublicKey) *TokenUpdateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenUpdateTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenUpdateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -486,6 +499,12 @@ func (tx *TokenUpdateTransaction) SetMaxBackoff(max time.Duration) *TokenUpdateT
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenUpdateTransaction) SetSingleNode(singleNode bool) *TokenUpdateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenUpdateTransaction) SetMinBackoff(min time.Duration) *TokenUpdateTransaction {
 	tx.Transaction.SetMinBackoff(min)
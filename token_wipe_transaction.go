@@ -181,6 +181,19 @@ func (tx *TokenWipeTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TokenWipeTransaction) SignWithSigner(signer Signer) *TokenWipeTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TokenWipeTransaction.
+func (tx *TokenWipeTransaction) SetSigningKeys(keys []PublicKey) *TokenWipeTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TokenWipeTransaction) AddSignature(publicKey PublicKey, signature []byte) *TokenWipeTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -254,6 +267,12 @@ func (tx *TokenWipeTransaction) SetMaxBackoff(max time.Duration) *TokenWipeTrans
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TokenWipeTransaction) SetSingleNode(singleNode bool) *TokenWipeTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TokenWipeTransaction) SetMinBackoff(min time.Duration) *TokenWipeTransaction {
 	tx.Transaction.SetMinBackoff(min)
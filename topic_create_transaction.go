@@ -124,7 +124,7 @@ func (tx *TopicCreateTransaction) GetAutoRenewPeriod() time.Duration {
 // topic. The topic lifetime will be extended up to a maximum of the autoRenewPeriod or however long the topic can be
 // extended using all funds on the account (whichever is the smaller duration/amount).
 //
-// If specified, there must be an adminKey and the autoRenewAccount must sign this transaction.
+// If specified, there must be an adminKey and an autoRenewPeriod, and the autoRenewAccount must sign this transaction.
 func (tx *TopicCreateTransaction) SetAutoRenewAccountID(autoRenewAccountID AccountID) *TopicCreateTransaction {
 	tx._RequireNotFrozen()
 	tx.autoRenewAccountID = &autoRenewAccountID
@@ -167,6 +167,19 @@ func (tx *TopicCreateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TopicCreateTransaction) SignWithSigner(signer Signer) *TopicCreateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TopicCreateTransaction.
+func (tx *TopicCreateTransaction) SetSigningKeys(keys []PublicKey) *TopicCreateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TopicCreateTransaction) AddSignature(publicKey PublicKey, signature []byte) *TopicCreateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -246,6 +259,12 @@ func (tx *TopicCreateTransaction) SetMaxBackoff(max time.Duration) *TopicCreateT
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TopicCreateTransaction) SetSingleNode(singleNode bool) *TopicCreateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TopicCreateTransaction) SetMinBackoff(min time.Duration) *TopicCreateTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -271,6 +290,14 @@ func (tx *TopicCreateTransaction) getName() string {
 	return "TopicCreateTransaction"
 }
 
+func (tx *TopicCreateTransaction) validateBeforeFreeze(client *Client) error {
+	if tx.autoRenewAccountID != nil && (tx.autoRenewPeriod == nil || *tx.autoRenewPeriod <= 0) {
+		return ErrAutoRenewPeriodNotSet
+	}
+
+	return nil
+}
+
 func (tx *TopicCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -337,3 +337,47 @@ func TestUnitTopicCreateTransactionSerialization(t *testing.T) {
 	submitKey, _ := result.GetSubmitKey()
 	require.Equal(t, newKey.PublicKey(), submitKey)
 }
+
+func TestUnitTopicCreateTransactionAutoRenewAccountSerialization(t *testing.T) {
+	t.Parallel()
+
+	nodeAccountID := []AccountID{{Account: 10}}
+	transactionID := TransactionIDGenerate(AccountID{Account: 324})
+	autoRenewAccount := AccountID{Account: 5}
+
+	topicCreate, err := NewTopicCreateTransaction().
+		SetTransactionID(transactionID).
+		SetNodeAccountIDs(nodeAccountID).
+		SetAutoRenewAccountID(autoRenewAccount).
+		SetAutoRenewPeriod(time.Hour).
+		Freeze()
+	require.NoError(t, err)
+
+	body := topicCreate.build().GetConsensusCreateTopic()
+	require.Equal(t, autoRenewAccount._ToProtobuf().String(), body.GetAutoRenewAccount().String())
+	require.Equal(t, int64(3600), body.GetAutoRenewPeriod().GetSeconds())
+
+	transactionBytes, err := topicCreate.ToBytes()
+	require.NoError(t, err)
+
+	txParsed, err := TransactionFromBytes(transactionBytes)
+	require.NoError(t, err)
+
+	result, ok := txParsed.(TopicCreateTransaction)
+	require.True(t, ok)
+
+	require.Equal(t, autoRenewAccount, result.GetAutoRenewAccountID())
+	require.Equal(t, time.Hour, result.GetAutoRenewPeriod())
+}
+
+func TestUnitTopicCreateTransactionAutoRenewAccountRequiresPeriod(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTopicCreateTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 324})).
+		SetNodeAccountIDs([]AccountID{{Account: 10}}).
+		SetAutoRenewAccountID(AccountID{Account: 5}).
+		SetAutoRenewPeriod(0).
+		Freeze()
+	require.ErrorIs(t, err, ErrAutoRenewPeriodNotSet)
+}
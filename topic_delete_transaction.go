@@ -94,6 +94,19 @@ func (tx *TopicDeleteTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TopicDeleteTransaction) SignWithSigner(signer Signer) *TopicDeleteTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TopicDeleteTransaction.
+func (tx *TopicDeleteTransaction) SetSigningKeys(keys []PublicKey) *TopicDeleteTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TopicDeleteTransaction) AddSignature(publicKey PublicKey, signature []byte) *TopicDeleteTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -173,6 +186,12 @@ func (tx *TopicDeleteTransaction) SetMaxBackoff(max time.Duration) *TopicDeleteT
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TopicDeleteTransaction) SetSingleNode(singleNode bool) *TopicDeleteTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TopicDeleteTransaction) SetMinBackoff(min time.Duration) *TopicDeleteTransaction {
 	tx.Transaction.SetMinBackoff(min)
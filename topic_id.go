@@ -64,7 +64,7 @@ func (id *TopicID) ValidateChecksum(client *Client) error {
 			return err
 		}
 		if id.checksum == nil {
-			return errChecksumMissing
+			return ErrChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
 			networkName := NetworkNameOther
@@ -97,18 +97,12 @@ func (id TopicID) String() string {
 
 // ToStringWithChecksum returns the string representation of a TopicID in `Shard.Realm.Topic-Checksum` (for example "0.0.3-abcde")
 func (id TopicID) ToStringWithChecksum(client Client) (string, error) {
-	if client.GetNetworkName() == nil && client.GetLedgerID() == nil {
-		return "", errNetworkNameMissing
-	}
-	var checksum _ParseAddressResult
-	var err error
-	if client.network.ledgerID != nil {
-		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Topic))
-	}
+	checksum, err := _ChecksumForID(&client, id.Shard, id.Realm, id.Topic)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Topic, checksum.correctChecksum), nil
+
+	return fmt.Sprintf("%d.%d.%d-%s", id.Shard, id.Realm, id.Topic, checksum), nil
 }
 
 func (id TopicID) _ToProtobuf() *services.TopicID {
@@ -144,7 +138,7 @@ func (id TopicID) ToBytes() []byte {
 // TopicIDFromBytes constructs a TopicID from a byte array
 func TopicIDFromBytes(data []byte) (TopicID, error) {
 	if data == nil {
-		return TopicID{}, errByteArrayNull
+		return TopicID{}, ErrByteArrayNull
 	}
 	pb := services.TopicID{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -42,7 +42,7 @@ type TopicInfo struct {
 
 func _TopicInfoFromProtobuf(topicInfo *services.ConsensusTopicInfo) (TopicInfo, error) {
 	if topicInfo == nil {
-		return TopicInfo{}, errParameterNull
+		return TopicInfo{}, ErrParameterNull
 	}
 	var err error
 	tempTopicInfo := TopicInfo{
@@ -105,7 +105,7 @@ func (topicInfo TopicInfo) ToBytes() []byte {
 // TopicInfoFromBytes returns a TopicInfo object from a byte array
 func TopicInfoFromBytes(data []byte) (TopicInfo, error) {
 	if data == nil {
-		return TopicInfo{}, errByteArrayNull
+		return TopicInfo{}, ErrByteArrayNull
 	}
 	pb := services.ConsensusTopicInfo{}
 	err := protobuf.Unmarshal(data, &pb)
@@ -84,6 +84,19 @@ func (q *TopicInfoQuery) SetMaxQueryPayment(maxPayment Hbar) *TopicInfoQuery {
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *TopicInfoQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *TopicInfoQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *TopicInfoQuery) SetRequestStateProof(request bool) *TopicInfoQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetQueryPayment sets the payment amount for this Query.
 func (q *TopicInfoQuery) SetQueryPayment(paymentAmount Hbar) *TopicInfoQuery {
 	q.Query.SetQueryPayment(paymentAmount)
@@ -109,6 +122,12 @@ func (q *TopicInfoQuery) SetMaxBackoff(max time.Duration) *TopicInfoQuery {
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *TopicInfoQuery) SetSingleNode(singleNode bool) *TopicInfoQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *TopicInfoQuery) SetMinBackoff(min time.Duration) *TopicInfoQuery {
 	q.Query.SetMinBackoff(min)
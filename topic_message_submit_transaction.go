@@ -129,6 +129,19 @@ func (tx *TopicMessageSubmitTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TopicMessageSubmitTransaction) SignWithSigner(signer Signer) *TopicMessageSubmitTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TopicMessageSubmitTransaction.
+func (tx *TopicMessageSubmitTransaction) SetSigningKeys(keys []PublicKey) *TopicMessageSubmitTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TopicMessageSubmitTransaction) AddSignature(publicKey PublicKey, signature []byte) *TopicMessageSubmitTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -149,19 +162,20 @@ func (tx *TopicMessageSubmitTransaction) FreezeWith(client *Client) (*TopicMessa
 	var err error
 	if tx.nodeAccountIDs._Length() == 0 {
 		if client == nil {
-			return tx, errNoClientOrTransactionIDOrNodeId
+			return tx, ErrFreezeFailed{Err: ErrNoClientOrTransactionIDOrNodeID}
 		}
 
-		tx.SetNodeAccountIDs(client.network._GetNodeAccountIDsForExecute())
+		tx.SetNodeAccountIDs(client._NodeAccountIDsForExecute())
 	}
 
-	tx._InitFee(client)
+	tx._InitFee(client, tx.getName())
+	tx._InitTransactionValidDuration(client)
 	err = tx.validateNetworkOnIDs(client)
 	if err != nil {
-		return &TopicMessageSubmitTransaction{}, err
+		return &TopicMessageSubmitTransaction{}, ErrFreezeFailed{Err: err}
 	}
 	if err := tx._InitTransactionID(client); err != nil {
-		return tx, err
+		return tx, ErrFreezeFailed{Err: err}
 	}
 	body := tx.build()
 
@@ -283,6 +297,12 @@ func (tx *TopicMessageSubmitTransaction) SetMaxBackoff(max time.Duration) *Topic
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TopicMessageSubmitTransaction) SetSingleNode(singleNode bool) *TopicMessageSubmitTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TopicMessageSubmitTransaction) SetMinBackoff(min time.Duration) *TopicMessageSubmitTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -370,7 +390,7 @@ func (tx *TopicMessageSubmitTransaction) Execute(
 	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
-		return TransactionResponse{}, errNoClientProvided
+		return TransactionResponse{}, ErrNoClientProvided
 	}
 
 	if tx.freezeError != nil {
@@ -387,7 +407,7 @@ func (tx *TopicMessageSubmitTransaction) Execute(
 		return list[0], nil
 	}
 
-	return TransactionResponse{}, errNoTransactions
+	return TransactionResponse{}, ErrNoTransactions
 }
 
 // ExecuteAll executes the all the Transactions with the provided client
@@ -406,11 +426,8 @@ func (tx *TopicMessageSubmitTransaction) ExecuteAll(
 		accountID = *transactionID.AccountID
 	}
 
-	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(accountID) {
-		tx.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+	if err := tx._AutoSignWithOperator(client, accountID); err != nil {
+		return []TransactionResponse{}, err
 	}
 
 	size := tx.signedTransactions._Length() / tx.nodeAccountIDs._Length()
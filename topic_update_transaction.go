@@ -232,6 +232,19 @@ func (tx *TopicUpdateTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TopicUpdateTransaction) SignWithSigner(signer Signer) *TopicUpdateTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TopicUpdateTransaction.
+func (tx *TopicUpdateTransaction) SetSigningKeys(keys []PublicKey) *TopicUpdateTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TopicUpdateTransaction) AddSignature(publicKey PublicKey, signature []byte) *TopicUpdateTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -311,6 +324,12 @@ func (tx *TopicUpdateTransaction) SetMaxBackoff(max time.Duration) *TopicUpdateT
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TopicUpdateTransaction) SetSingleNode(singleNode bool) *TopicUpdateTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TopicUpdateTransaction) SetMinBackoff(min time.Duration) *TopicUpdateTransaction {
 	tx.Transaction.SetMinBackoff(min)
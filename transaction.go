@@ -22,9 +22,13 @@ package hedera
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
 	"reflect"
+	"sort"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -35,6 +39,13 @@ import (
 	protobuf "google.golang.org/protobuf/proto"
 )
 
+const defaultTransactionValidDuration = 120 * time.Second
+
+// maxTransactionValidDuration is the longest valid duration the network accepts.
+const maxTransactionValidDuration = 180 * time.Second
+
+const defaultMaxTransactionMemoBytes = 100
+
 // transaction contains the protobuf of a prepared transaction which can be signed and executed.
 
 type ITransaction interface {
@@ -46,16 +57,27 @@ type TransactionInterface interface {
 
 	build() *services.TransactionBody
 	buildScheduled() (*services.SchedulableTransactionBody, error)
+	IsSchedulable() bool
 	preFreezeWith(*Client)
+	validateBeforeFreeze(*Client) error
 	regenerateID(*Client) bool
 }
 
 // Transaction is base struct for all transactions that may be built and submitted to Hedera.
+//
+// A transaction can be frozen without a client, e.g. on an air-gapped machine, by calling Freeze instead of
+// FreezeWith. It then needs its transaction ID from SetTransactionID and its node account IDs from
+// SetNodeAccountIDs, since there is no operator to generate an ID and no network to pick nodes from. Without a
+// client the max transaction fee defaults to the transaction type's default unless SetMaxTransactionFee is
+// called, the valid duration defaults to 120 seconds, and checksums are not validated. The frozen transaction
+// can then be signed and serialized with ToBytes.
 type Transaction struct {
 	executable
 
 	transactionFee           uint64
 	defaultMaxTransactionFee uint64
+	// requiredFee is the fee a node asked for when it rejected the transaction with INSUFFICIENT_TX_FEE
+	requiredFee              uint64
 	memo                     string
 	transactionValidDuration *time.Duration
 	transactionID            TransactionID
@@ -64,7 +86,9 @@ type Transaction struct {
 	signedTransactions *_LockableSlice
 
 	publicKeys         []PublicKey
-	transactionSigners []TransactionSigner
+	transactionSigners []*_TransactionSigner
+	// signingKeys are the keys expected to sign, for reporting progress only; they are never serialized
+	signingKeys []PublicKey
 
 	freezeError error
 
@@ -72,20 +96,14 @@ type Transaction struct {
 }
 
 func _NewTransaction() Transaction {
-	duration := 120 * time.Second
-	minBackoff := 250 * time.Millisecond
-	maxBackoff := 8 * time.Second
 	return Transaction{
-		transactionValidDuration: &duration,
-		transactions:             _NewLockableSlice(),
-		signedTransactions:       _NewLockableSlice(),
-		freezeError:              nil,
-		regenerateTransactionID:  true,
+		transactions:            _NewLockableSlice(),
+		signedTransactions:      _NewLockableSlice(),
+		freezeError:             nil,
+		regenerateTransactionID: true,
 		executable: executable{
 			transactionIDs: _NewLockableSlice(),
 			nodeAccountIDs: _NewLockableSlice(),
-			minBackoff:     &minBackoff,
-			maxBackoff:     &maxBackoff,
 			maxRetry:       10,
 		},
 	}
@@ -98,11 +116,9 @@ func (tx *Transaction) GetSignedTransactionBodyBytes(transactionIndex int) []byt
 // TransactionFromBytes converts transaction bytes to a related *transaction.
 func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 	list := sdk.TransactionList{}
-	minBackoff := 250 * time.Millisecond
-	maxBackoff := 8 * time.Second
 	err := protobuf.Unmarshal(data, &list)
 	if err != nil {
-		return Transaction{}, errors.Wrap(err, "error deserializing from bytes to transaction List")
+		return Transaction{}, ErrSerialization{Message: "error deserializing from bytes to transaction List", Err: err}
 	}
 
 	transactions := _NewLockableSlice()
@@ -115,14 +131,12 @@ func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 		transactions:            transactions,
 		signedTransactions:      _NewLockableSlice(),
 		publicKeys:              make([]PublicKey, 0),
-		transactionSigners:      make([]TransactionSigner, 0),
+		transactionSigners:      make([]*_TransactionSigner, 0),
 		freezeError:             nil,
 		regenerateTransactionID: true,
 		executable: executable{
 			transactionIDs: _NewLockableSlice(),
 			nodeAccountIDs: _NewLockableSlice(),
-			minBackoff:     &minBackoff,
-			maxBackoff:     &maxBackoff,
 			maxRetry:       10,
 		},
 	}
@@ -133,7 +147,7 @@ func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 	}
 
 	if !comp {
-		return Transaction{}, errors.New("failed to validate transaction bodies")
+		return Transaction{}, ErrTransactionBodiesMismatch
 	}
 
 	var first *services.TransactionBody = nil
@@ -148,11 +162,11 @@ func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 		if len(transactionFromList.SignedTransactionBytes) == 0 {
 			txIsSigned = false
 			if err := protobuf.Unmarshal(transactionFromList.BodyBytes, &body); err != nil { // nolint
-				return Transaction{}, errors.Wrap(err, "error deserializing BodyBytes in TransactionFromBytes")
+				return Transaction{}, ErrSerialization{Message: "error deserializing BodyBytes in TransactionFromBytes", Err: err}
 			}
 		} else { // If the transaction is signed/locked
 			if err := protobuf.Unmarshal(transactionFromList.SignedTransactionBytes, &signedTransaction); err != nil {
-				return Transaction{}, errors.Wrap(err, "error deserializing SignedTransactionBytes in TransactionFromBytes")
+				return Transaction{}, ErrSerialization{Message: "error deserializing SignedTransactionBytes in TransactionFromBytes", Err: err}
 			}
 		}
 
@@ -172,7 +186,7 @@ func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 			}
 
 			if err := protobuf.Unmarshal(signedTransaction.GetBodyBytes(), &body); err != nil {
-				return Transaction{}, errors.Wrap(err, "error deserializing BodyBytes in TransactionFromBytes")
+				return Transaction{}, ErrSerialization{Message: "error deserializing BodyBytes in TransactionFromBytes", Err: err}
 			}
 		}
 
@@ -222,7 +236,7 @@ func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 	}
 
 	if first == nil {
-		return nil, errNoTransactionInBytes
+		return nil, ErrNoTransactionInBytes
 	}
 
 	switch first.Data.(type) {
@@ -315,7 +329,7 @@ func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 	case *services.TransactionBody_TokenUpdateNfts:
 		return *_NewTokenUpdateNftsTransactionFromProtobuf(tx, first), nil
 	default:
-		return Transaction{}, errFailedToDeserializeBytes
+		return Transaction{}, ErrFailedToDeserializeBytes
 	}
 }
 
@@ -350,6 +364,45 @@ func _TransactionCompare(list *sdk.TransactionList) (bool, error) {
 	return true, nil
 }
 
+// TransactionFromBase64 decodes a transaction encoded with ToBase64, signatures included, the same way as
+// TransactionFromBytes.
+func TransactionFromBase64(s string) (interface{}, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return Transaction{}, ErrSerialization{Message: "error decoding base64 transaction", Err: err}
+	}
+
+	return TransactionFromBytes(data)
+}
+
+// TransactionFromMirrorBytes converts a SignedTransaction, as served (base64 decoded) by the mirror node
+// for historical transactions, to a related *transaction, the same way as TransactionFromBytes.
+// The result is meant for inspecting what the transaction did; it is frozen and carries the original
+// signatures, so it is generally not executable again.
+func TransactionFromMirrorBytes(data []byte) (interface{}, error) {
+	if data == nil {
+		return Transaction{}, ErrByteArrayNull
+	}
+
+	var signedTransaction services.SignedTransaction
+	if err := protobuf.Unmarshal(data, &signedTransaction); err != nil {
+		return Transaction{}, ErrSerialization{Message: "error deserializing SignedTransaction in TransactionFromMirrorBytes", Err: err}
+	}
+
+	if len(signedTransaction.GetBodyBytes()) == 0 {
+		return Transaction{}, ErrNoTransactionInBytes
+	}
+
+	list, err := protobuf.Marshal(&sdk.TransactionList{
+		TransactionList: []*services.Transaction{{SignedTransactionBytes: data}},
+	})
+	if err != nil {
+		return Transaction{}, ErrSerialization{Message: "error serializing transaction list in TransactionFromMirrorBytes", Err: err}
+	}
+
+	return TransactionFromBytes(list)
+}
+
 // GetSignatures Gets all of the signatures stored in the transaction
 func (tx *Transaction) GetSignatures() (map[AccountID]map[*PublicKey][]byte, error) {
 	returnMap := make(map[AccountID]map[*PublicKey][]byte, tx.nodeAccountIDs._Length())
@@ -413,7 +466,7 @@ func (tx *Transaction) GetTransactionHash() ([]byte, error) {
 func (tx *Transaction) GetTransactionHashPerNode() (map[AccountID][]byte, error) {
 	transactionHash := make(map[AccountID][]byte)
 	if !tx.IsFrozen() {
-		return transactionHash, errTransactionIsNotFrozen
+		return transactionHash, ErrTransactionIsNotFrozen
 	}
 
 	allTx, err := tx._BuildAllTransactions()
@@ -444,13 +497,41 @@ func (tx *Transaction) GetTransactionHashPerNode() (map[AccountID][]byte, error)
 // 1. Explicitly set for this Transaction
 // 2. Client has a default value set for all transactions
 // 3. The default for this type of Transaction, which is set during creation
-func (tx *Transaction) _InitFee(client *Client) {
-	if tx.transactionFee == 0 {
-		if client != nil && client.GetDefaultMaxTransactionFee().AsTinybar() != 0 {
-			tx.SetMaxTransactionFee(client.GetDefaultMaxTransactionFee())
-		} else {
-			tx.SetMaxTransactionFee(tx.GetDefaultMaxTransactionFee())
+// _InitFee sets the max transaction fee of a transaction of the given type which doesn't have its own.
+func (tx *Transaction) _InitFee(client *Client, transactionType string) {
+	if tx.transactionFee != 0 {
+		return
+	}
+
+	tx.SetMaxTransactionFee(tx._MaxFee(client, transactionType))
+}
+
+// _MaxFee returns the max transaction fee freezing with client would use, without setting it.
+func (tx *Transaction) _MaxFee(client *Client, transactionType string) Hbar {
+	if tx.transactionFee != 0 {
+		return HbarFromTinybar(int64(tx.transactionFee))
+	}
+
+	if client != nil && client.GetDefaultMaxTransactionFee().AsTinybar() != 0 {
+		return client.GetDefaultMaxTransactionFee()
+	}
+
+	fee := tx.GetDefaultMaxTransactionFee()
+	if client != nil {
+		if networkFee := client._NetworkMaxTransactionFee(); networkFee.AsTinybar() > fee.AsTinybar() {
+			fee = networkFee
 		}
+		if learnedFee, ok := client.GetEstimatedMaxFee(transactionType); ok && learnedFee.AsTinybar() > fee.AsTinybar() {
+			fee = learnedFee
+		}
+	}
+
+	return fee
+}
+
+func (tx *Transaction) _InitTransactionValidDuration(client *Client) {
+	if tx.transactionValidDuration == nil && client != nil && client.GetDefaultTransactionValidDuration() != 0 {
+		tx.SetTransactionValidDuration(client.GetDefaultTransactionValidDuration())
 	}
 }
 
@@ -459,12 +540,12 @@ func (tx *Transaction) _InitTransactionID(client *Client) error {
 		if client != nil {
 			if client.operator != nil {
 				tx.transactionIDs = _NewLockableSlice()
-				tx.transactionIDs = tx.transactionIDs._Push(TransactionIDGenerate(client.operator.accountID))
+				tx.transactionIDs = tx.transactionIDs._Push(client._GenerateTransactionID(client.operator.accountID))
 			} else {
-				return errNoClientOrTransactionID
+				return ErrNoClientOrTransactionID
 			}
 		} else {
-			return errNoClientOrTransactionID
+			return ErrNoClientOrTransactionID
 		}
 	}
 
@@ -478,7 +559,7 @@ func (tx *Transaction) IsFrozen() bool {
 
 func (tx *Transaction) _RequireNotFrozen() {
 	if tx.IsFrozen() {
-		tx.freezeError = errTransactionIsFrozen
+		tx.freezeError = ErrTransactionIsFrozen
 	}
 }
 
@@ -488,18 +569,38 @@ func (tx *Transaction) _RequireOneNodeAccountID() {
 	}
 }
 
+// _ValidateMemo checks the memo against the client's memo length limit, or the default limit without a client.
+func (tx *Transaction) _ValidateMemo(client *Client) error {
+	maxMemoBytes := defaultMaxTransactionMemoBytes
+	if client != nil {
+		maxMemoBytes = client.GetMaxTransactionMemoBytes()
+	}
+	if len(tx.memo) > maxMemoBytes {
+		return ErrMemoTooLong{Length: len(tx.memo), MaxLength: maxMemoBytes}
+	}
+
+	return nil
+}
+
 func _TransactionFreezeWith(
 	transaction *Transaction,
 	client *Client,
 	body *services.TransactionBody,
 ) error {
+	if err := transaction._ValidateMemo(client); err != nil {
+		return err
+	}
+
 	if transaction.nodeAccountIDs._IsEmpty() {
 		if client != nil {
-			for _, nodeAccountID := range client.network._GetNodeAccountIDsForExecute() {
+			for _, nodeAccountID := range client._NodeAccountIDsForExecute() {
 				transaction.nodeAccountIDs._Push(nodeAccountID)
+				if transaction.singleNode {
+					break
+				}
 			}
 		} else {
-			return errNoClientOrTransactionIDOrNodeId
+			return ErrNoClientOrTransactionIDOrNodeID
 		}
 	}
 
@@ -531,7 +632,7 @@ func _TransactionFreezeWith(
 
 func (tx *Transaction) _SignWith(
 	publicKey PublicKey,
-	signer TransactionSigner,
+	signer *_TransactionSigner,
 ) {
 	tx.transactions = _NewLockableSlice()
 	tx.publicKeys = append(tx.publicKeys, publicKey)
@@ -550,6 +651,16 @@ func (tx *Transaction) _KeyAlreadySigned(
 	return false
 }
 
+// GetSignedPublicKeys returns the public keys that have signed the transaction, whether with Sign, SignWith,
+// AddSignature or in the bytes it was deserialized from. Use CheckSignatures or GetSigningProgress to find out
+// which signatures are still missing, and GetSignatures for the signature bytes.
+func (tx *Transaction) GetSignedPublicKeys() []PublicKey {
+	keys := make([]PublicKey, len(tx.publicKeys))
+	copy(keys, tx.publicKeys)
+
+	return keys
+}
+
 // String returns a string representation of the transaction
 func (tx *Transaction) String() string {
 	switch sig := tx.signedTransactions._Get(0).(type) { //nolint
@@ -566,6 +677,21 @@ func (tx *Transaction) ToBytes() ([]byte, error) {
 	return tx.toBytes(tx)
 }
 
+// ToBase64 converts the frozen transaction, including its signatures, to standard base64 encoded bytes
+// for relaying as a string. Use TransactionFromBase64 to decode it.
+func (tx *Transaction) ToBase64() (string, error) {
+	if !tx.IsFrozen() {
+		return "", ErrTransactionIsNotFrozen
+	}
+
+	data, err := tx.ToBytes()
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
 func (tx *Transaction) toBytes(e TransactionInterface) ([]byte, error) {
 	var pbTransactionList []byte
 	var allTx []*services.Transaction
@@ -586,7 +712,7 @@ func (tx *Transaction) toBytes(e TransactionInterface) ([]byte, error) {
 		TransactionList: allTx,
 	})
 	if err != nil {
-		return make([]byte, 0), errors.Wrap(err, "error serializing tx list")
+		return make([]byte, 0), ErrSerialization{Message: "error serializing tx list", Err: err}
 	}
 	return pbTransactionList, nil
 }
@@ -621,13 +747,19 @@ func (tx *Transaction) buildUnsignedTransaction(e TransactionInterface, index in
 
 	bodyBytes, err := protobuf.Marshal(body)
 	if err != nil {
-		return &services.Transaction{}, errors.Wrap(err, "failed to update tx ID")
+		return &services.Transaction{}, ErrSerialization{Message: "failed to update tx ID", Err: err}
 	}
 
 	return &services.Transaction{BodyBytes: bodyBytes}, nil
 }
 
-func (tx *Transaction) _SignTransaction(index int) {
+// _SignTransaction signs the body at index with every signer, see _SignTransactions.
+func (tx *Transaction) _SignTransaction(index int) error {
+	return tx._SignTransactions([]int{index})
+}
+
+// _NeedsSigning reports whether the body at index still has to be signed by the transaction's signers.
+func (tx *Transaction) _NeedsSigning(index int) bool {
 	initialTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
 	bodyBytes := initialTx.GetBodyBytes()
 	if len(initialTx.SigMap.SigPair) != 0 {
@@ -636,12 +768,12 @@ func (tx *Transaction) _SignTransaction(index int) {
 				if key.ed25519PublicKey != nil {
 					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ed25519PublicKey.keyData) {
 						if !tx.regenerateTransactionID {
-							return
+							return false
 						}
 						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
 						case *services.SignaturePair_Ed25519:
-							if bytes.Equal(t.Ed25519, tx.transactionSigners[0](bodyBytes)) && len(t.Ed25519) > 0 {
-								return
+							if signature, err := tx.transactionSigners[0].sign(bodyBytes); err == nil && bytes.Equal(t.Ed25519, signature) && len(t.Ed25519) > 0 {
+								return false
 							}
 						}
 					}
@@ -649,12 +781,12 @@ func (tx *Transaction) _SignTransaction(index int) {
 				if key.ecdsaPublicKey != nil {
 					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ecdsaPublicKey._BytesRaw()) {
 						if !tx.regenerateTransactionID {
-							return
+							return false
 						}
 						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
 						case *services.SignaturePair_ECDSASecp256K1:
-							if bytes.Equal(t.ECDSASecp256K1, tx.transactionSigners[0](bodyBytes)) && len(t.ECDSASecp256K1) > 0 {
-								return
+							if signature, err := tx.transactionSigners[0].sign(bodyBytes); err == nil && bytes.Equal(t.ECDSASecp256K1, signature) && len(t.ECDSASecp256K1) > 0 {
+								return false
 							}
 						}
 					}
@@ -663,31 +795,30 @@ func (tx *Transaction) _SignTransaction(index int) {
 		}
 	}
 
-	if tx.regenerateTransactionID && !tx.transactionIDs.locked {
-		modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-		modifiedTx.SigMap.SigPair = make([]*services.SignaturePair, 0)
-		tx.signedTransactions._Set(index, modifiedTx)
-	}
-
-	for i := 0; i < len(tx.publicKeys); i++ {
-		publicKey := tx.publicKeys[i]
-		signer := tx.transactionSigners[i]
+	return true
+}
 
-		if signer == nil {
-			continue
+func (tx *Transaction) _BuildAllTransactions() ([]*services.Transaction, error) {
+	length := tx.signedTransactions._Length()
+	unsigned := make([]int, 0, length)
+	for i := 0; i < length; i++ {
+		signed, err := tx._PrepareTransaction(i)
+		tx.transactionIDs._Advance()
+		if err != nil {
+			return []*services.Transaction{}, err
+		}
+		if !signed {
+			unsigned = append(unsigned, i)
 		}
+	}
 
-		modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-		modifiedTx.SigMap.SigPair = append(modifiedTx.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signer(bodyBytes)))
-		tx.signedTransactions._Set(index, modifiedTx)
+	if err := tx._SignTransactions(unsigned); err != nil {
+		return []*services.Transaction{}, err
 	}
-}
 
-func (tx *Transaction) _BuildAllTransactions() ([]*services.Transaction, error) {
-	allTx := make([]*services.Transaction, 0)
-	for i := 0; i < tx.signedTransactions._Length(); i++ {
-		curr, err := tx._BuildTransaction(i)
-		tx.transactionIDs._Advance()
+	allTx := make([]*services.Transaction, 0, length)
+	for i := 0; i < length; i++ {
+		curr, err := tx._SerializeTransaction(i)
 		if err != nil {
 			return []*services.Transaction{}, err
 		}
@@ -698,6 +829,23 @@ func (tx *Transaction) _BuildAllTransactions() ([]*services.Transaction, error)
 }
 
 func (tx *Transaction) _BuildTransaction(index int) (*services.Transaction, error) {
+	signed, err := tx._PrepareTransaction(index)
+	if err != nil {
+		return &services.Transaction{}, err
+	}
+
+	if !signed {
+		if err := tx._SignTransaction(index); err != nil {
+			return &services.Transaction{}, err
+		}
+	}
+
+	return tx._SerializeTransaction(index)
+}
+
+// _PrepareTransaction brings the body at index up to date with the current transaction ID, node, memo and fee.
+// It reports whether the body is unchanged and already carries a signature from every signer.
+func (tx *Transaction) _PrepareTransaction(index int) (bool, error) {
 	signedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
 
 	txID := tx.transactionIDs._GetCurrent().(TransactionID)
@@ -721,7 +869,7 @@ func (tx *Transaction) _BuildTransaction(index int) (*services.Transaction, erro
 
 	updatedBody, err := protobuf.Marshal(&originalBody)
 	if err != nil {
-		return &services.Transaction{}, errors.Wrap(err, "failed to update tx ID")
+		return false, ErrSerialization{Message: "failed to update tx ID", Err: err}
 	}
 
 	// Bellow are checks whether we need to sign the transaction or we already have the same signed
@@ -729,33 +877,87 @@ func (tx *Transaction) _BuildTransaction(index int) (*services.Transaction, erro
 		sigPairLen := len(signedTx.SigMap.GetSigPair())
 		// For cases where we need more than 1 signature
 		if sigPairLen > 0 && sigPairLen == len(tx.publicKeys) {
-			data, err := protobuf.Marshal(signedTx)
-			if err != nil {
-				return &services.Transaction{}, errors.Wrap(err, "failed to serialize transactions for building")
-			}
-			transaction := &services.Transaction{
-				SignedTransactionBytes: data,
-			}
-
-			return transaction, nil
+			return true, nil
 		}
 	}
 
 	signedTx.BodyBytes = updatedBody
 	tx.signedTransactions._Set(index, signedTx)
-	tx._SignTransaction(index)
 
+	return false, nil
+}
+
+// _SignTransactions signs the bodies at the given indexes with every signer. Each signer is called for one body
+// after the other, unless it is a ConcurrentSigner, and the signatures are only added to the signature maps once
+// every signer has signed every body, so a signer error leaves the transaction as it was.
+func (tx *Transaction) _SignTransactions(indexes []int) error {
+	pending := make([]int, 0, len(indexes))
+	bodies := make([][]byte, 0, len(indexes))
+	for _, index := range indexes {
+		if tx._NeedsSigning(index) {
+			pending = append(pending, index)
+			bodies = append(bodies, tx.signedTransactions._Get(index).(*services.SignedTransaction).GetBodyBytes())
+		}
+	}
+
+	sigPairs := make([][]*services.SignaturePair, len(pending))
+	for i, publicKey := range tx.publicKeys {
+		signer := tx.transactionSigners[i]
+		if signer == nil {
+			continue
+		}
+
+		signatures, err := signer._SignAll(bodies)
+		if err != nil {
+			return ErrSignerFailed{PublicKey: publicKey, Err: err}
+		}
+		for body, signature := range signatures {
+			sigPairs[body] = append(sigPairs[body], publicKey._ToSignaturePairProtobuf(signature))
+		}
+	}
+
+	for body, index := range pending {
+		modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+		if tx.regenerateTransactionID && !tx.transactionIDs.locked {
+			modifiedTx.SigMap.SigPair = make([]*services.SignaturePair, 0)
+		}
+		modifiedTx.SigMap.SigPair = append(modifiedTx.SigMap.SigPair, sigPairs[body]...)
+		tx.signedTransactions._Set(index, modifiedTx)
+	}
+
+	return nil
+}
+
+func (tx *Transaction) _SerializeTransaction(index int) (*services.Transaction, error) {
 	signed := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-	data, err := protobuf.Marshal(signed)
+	data, err := protobuf.Marshal(_SortSignaturePairs(signed))
 	if err != nil {
-		return &services.Transaction{}, errors.Wrap(err, "failed to serialize transactions for building")
+		return &services.Transaction{}, ErrSerialization{Message: "failed to serialize transactions for building", Err: err}
 	}
 
-	transaction := &services.Transaction{
+	return &services.Transaction{
 		SignedTransactionBytes: data,
+	}, nil
+}
+
+// _SortSignaturePairs returns signed with its signature pairs ordered by public key prefix, so the serialized
+// transaction doesn't depend on the order in which its signatures were added. signed itself is left untouched.
+func _SortSignaturePairs(signed *services.SignedTransaction) *services.SignedTransaction {
+	sigPairs := signed.GetSigMap().GetSigPair()
+	if len(sigPairs) < 2 {
+		return signed
 	}
 
-	return transaction, nil
+	sorted := make([]*services.SignaturePair, len(sigPairs))
+	copy(sorted, sigPairs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].PubKeyPrefix, sorted[j].PubKeyPrefix) < 0
+	})
+
+	return &services.SignedTransaction{
+		BodyBytes: signed.BodyBytes,
+		SigMap:    &services.SignatureMap{SigPair: sorted},
+	}
 }
 
 //
@@ -804,12 +1006,14 @@ func (tx *Transaction) SetTransactionMemo(memo string) *Transaction {
 }
 
 // GetTransactionValidDuration returns the duration that this transaction is valid for.
+// Unless it was set, this is the client's default transaction valid duration once the transaction is frozen,
+// and 120 seconds otherwise.
 func (tx *Transaction) GetTransactionValidDuration() time.Duration {
 	if tx.transactionValidDuration != nil {
 		return *tx.transactionValidDuration
 	}
 
-	return 0
+	return defaultTransactionValidDuration
 }
 
 // SetTransactionValidDuration sets the valid duration for this transaction.
@@ -852,9 +1056,9 @@ func (tx *Transaction) signWithOperator(client *Client, e TransactionInterface)
 	// to sign the transaction with the _Operator
 
 	if client == nil {
-		return nil, errNoClientProvided
+		return nil, ErrNoClientProvided
 	} else if client.operator == nil {
-		return nil, errClientOperatorSigning
+		return nil, ErrClientOperatorSigning
 	}
 
 	if !tx.IsFrozen() {
@@ -863,15 +1067,277 @@ func (tx *Transaction) signWithOperator(client *Client, e TransactionInterface)
 			return tx, err
 		}
 	}
-	return tx.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return tx._SignWithOperator(client.operator), nil
+}
+
+// _SignWithOperator signs with the operator's key, going through its Signer when it was set with one.
+func (tx *Transaction) _SignWithOperator(operator *_Operator) TransactionInterface {
+	if !tx._KeyAlreadySigned(operator.publicKey) {
+		tx._SignWith(operator.publicKey, operator.signer)
+	}
+
+	return tx
+}
+
+// _AutoSignWithOperator signs with the client's operator when it pays for the transaction. If the client doesn't
+// auto-sign with the operator, the transaction is left as is but must already carry a signature.
+func (tx *Transaction) _AutoSignWithOperator(client *Client, payer AccountID) error {
+	if client.disableAutoSignWithOperator {
+		if len(tx.publicKeys) == 0 {
+			return ErrNoSignatures
+		}
+
+		return nil
+	}
+
+	// Without an operator nothing would sign the transaction, so the network would reject it
+	if client.operator == nil && len(tx.publicKeys) == 0 {
+		return ErrNoOperator
+	}
+
+	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(payer) {
+		tx._SignWithOperator(client.operator)
+	}
+
+	return nil
 }
+
 func (tx *Transaction) SignWith(publicKey PublicKey, signer TransactionSigner) TransactionInterface {
 	if !tx._KeyAlreadySigned(publicKey) {
-		tx._SignWith(publicKey, signer)
+		tx._SignWith(publicKey, _TransactionSignerForInfallible(publicKey, signer))
+	}
+
+	return tx
+}
+
+// SignWithSigner adds the Signer's public key to the transaction's signers. The Signer is asked to sign
+// every node's body when the transaction is built, and the first error it returns fails the build. Use
+// NewNodeBodySigner for a signer which decides per node whether to sign.
+func (tx *Transaction) SignWithSigner(signer Signer) TransactionInterface {
+	publicKey := signer.PublicKey()
+	if !tx._KeyAlreadySigned(publicKey) {
+		tx._SignWith(publicKey, _TransactionSignerFor(signer))
 	}
 
 	return tx
 }
+
+// SignWithContext signs each of the frozen transaction's node bodies with signer now, passing ctx to every
+// call and checking it before each one. Signatures are only attached once every body is signed; if ctx is done
+// or signer returns an error first, the transaction is left unchanged and ErrSignerFailed is returned.
+func (tx *Transaction) SignWithContext(ctx context.Context, publicKey PublicKey, signer TransactionSignerWithContext) error {
+	if !tx.IsFrozen() {
+		return ErrTransactionIsNotFrozen
+	}
+
+	if tx._KeyAlreadySigned(publicKey) {
+		return nil
+	}
+
+	signatures := make([][]byte, tx.signedTransactions._Length())
+	for index := range signatures {
+		if err := ctx.Err(); err != nil {
+			return ErrSignerFailed{PublicKey: publicKey, Err: err}
+		}
+
+		signature, err := signer(ctx, tx.signedTransactions._Get(index).(*services.SignedTransaction).GetBodyBytes())
+		if err != nil {
+			return ErrSignerFailed{PublicKey: publicKey, Err: err}
+		}
+
+		signatures[index] = signature
+	}
+
+	tx.transactions = _NewLockableSlice()
+	tx.publicKeys = append(tx.publicKeys, publicKey)
+	tx.transactionSigners = append(tx.transactionSigners, nil)
+	tx.transactionIDs.locked = true
+
+	for index, signature := range signatures {
+		temp := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+		temp.SigMap.SigPair = append(temp.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signature))
+		tx.signedTransactions._Set(index, temp)
+	}
+
+	return nil
+}
+
+// NodeBodySigner signs a transaction's body bytes for the node with the given account ID. Returning an error
+// refuses to sign that body, for example for a node which is not on an allowlist.
+type NodeBodySigner func(nodeAccountID AccountID, bodyBytes []byte) ([]byte, error)
+
+// NewNodeBodySigner returns a Signer for publicKey which asks signer to sign each node's body, for use with
+// SignWithSigner. The first error signer returns stops the remaining bodies from being signed and fails the
+// build with ErrSignerFailed.
+func NewNodeBodySigner(publicKey PublicKey, signer NodeBodySigner) Signer {
+	return &_TransactionSigner{publicKey: publicKey, sign: func(message []byte) ([]byte, error) {
+		var body services.TransactionBody
+		if err := protobuf.Unmarshal(message, &body); err != nil {
+			return nil, err
+		}
+
+		var nodeAccountID AccountID
+		if body.GetNodeAccountID() != nil {
+			nodeAccountID = *_AccountIDFromProtobuf(body.GetNodeAccountID())
+		}
+
+		return signer(nodeAccountID, message)
+	}}
+}
+
+// _TransactionSigner is the adapter every kind of signer is kept as on a transaction or operator: a
+// TransactionSigner, a Signer or a NodeBodySigner. It can fail, so that an error
+// stops the build instead of producing an empty signature, and it is itself a Signer.
+type _TransactionSigner struct {
+	publicKey PublicKey
+	sign      func(message []byte) ([]byte, error)
+	// concurrency is how many bodies sign may be called for at once. It is 1 unless the signer is a
+	// ConcurrentSigner, since other signers may keep state which isn't safe to share between goroutines.
+	concurrency int
+}
+
+// _TransactionSignerFor adapts a Signer, signing concurrently if it is a ConcurrentSigner.
+func _TransactionSignerFor(signer Signer) *_TransactionSigner {
+	if adapted, ok := signer.(*_TransactionSigner); ok {
+		return adapted
+	}
+
+	concurrency := 1
+	if concurrent, ok := signer.(ConcurrentSigner); ok && concurrent.SigningConcurrency() > 1 {
+		concurrency = concurrent.SigningConcurrency()
+	}
+
+	return &_TransactionSigner{publicKey: signer.PublicKey(), sign: signer.Sign, concurrency: concurrency}
+}
+
+// _TransactionSignerForInfallible adapts a TransactionSigner, which never fails.
+func _TransactionSignerForInfallible(publicKey PublicKey, signer TransactionSigner) *_TransactionSigner {
+	return &_TransactionSigner{publicKey: publicKey, sign: func(message []byte) ([]byte, error) {
+		return signer(message), nil
+	}}
+}
+
+// PublicKey returns the public key the signer signs for
+func (signer *_TransactionSigner) PublicKey() PublicKey {
+	return signer.publicKey
+}
+
+// Sign returns the signature of message
+func (signer *_TransactionSigner) Sign(message []byte) ([]byte, error) {
+	return signer.sign(message)
+}
+
+// _SignAll signs every message, returning the signatures in the same order. It stops at the first error.
+func (signer *_TransactionSigner) _SignAll(messages [][]byte) ([][]byte, error) {
+	signatures := make([][]byte, len(messages))
+	if signer.concurrency < 2 || len(messages) < 2 {
+		for i, message := range messages {
+			signature, err := signer.sign(message)
+			if err != nil {
+				return nil, err
+			}
+			signatures[i] = signature
+		}
+
+		return signatures, nil
+	}
+
+	errs := make([]error, len(messages))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, signer.concurrency)
+	for i, message := range messages {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, message []byte) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			signatures[i], errs[i] = signer.sign(message)
+		}(i, message)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return signatures, nil
+}
+
+// GetRemainingSignatures returns how many more signatures the given key needs from this transaction's
+// signers before it is satisfied. Keys which are not lists need exactly one signature.
+func (tx *Transaction) GetRemainingSignatures(key Key) int {
+	if keyList, ok := key.(*KeyList); ok {
+		return keyList.RemainingSignatures(tx.publicKeys)
+	}
+
+	if _KeyIsSatisfied(key, tx.publicKeys) {
+		return 0
+	}
+
+	return 1
+}
+
+// _SetSigningKeys records the keys which are expected to sign this transaction, so GetSigningProgress can
+// report how many of them have signed. It does not change the transaction's bytes. Each transaction type
+// exposes it as SetSigningKeys, returning its own type.
+func (tx *Transaction) _SetSigningKeys(keys []PublicKey) {
+	tx.signingKeys = append([]PublicKey{}, keys...)
+}
+
+// GetSigningKeys returns the keys which are expected to sign this transaction.
+func (tx *Transaction) GetSigningKeys() []PublicKey {
+	return tx.signingKeys
+}
+
+// SigningProgress splits the keys expected to sign a transaction into those which have signed and those which
+// have not.
+type SigningProgress struct {
+	Signed  []PublicKey
+	Missing []PublicKey
+}
+
+// String returns the progress as "2 of 3 expected keys have signed".
+func (progress SigningProgress) String() string {
+	return fmt.Sprintf("%d of %d expected keys have signed", len(progress.Signed), len(progress.Signed)+len(progress.Missing))
+}
+
+// GetSigningProgress returns which of the keys set with SetSigningKeys have signed this transaction.
+func (tx *Transaction) GetSigningProgress() SigningProgress {
+	progress := SigningProgress{
+		Signed:  make([]PublicKey, 0),
+		Missing: make([]PublicKey, 0),
+	}
+
+	for _, key := range tx.signingKeys {
+		if tx._KeyAlreadySigned(key) {
+			progress.Signed = append(progress.Signed, key)
+		} else {
+			progress.Missing = append(progress.Missing, key)
+		}
+	}
+
+	return progress
+}
+
+// CheckSignatures reports whether the signatures attached to this transaction satisfy the given key, such as
+// the key of a multi-sig account fetched with AccountInfoQuery. It returns ErrSignaturesMissing listing the
+// keys which have not signed when more signatures are needed. The operator's signature is only counted once
+// it has been attached, which Execute normally does.
+func (tx *Transaction) CheckSignatures(key Key) error {
+	remaining := tx.GetRemainingSignatures(key)
+	if remaining == 0 {
+		return nil
+	}
+
+	return ErrSignaturesMissing{
+		Remaining:   remaining,
+		MissingKeys: _UnsignedPublicKeys(key, tx.publicKeys),
+	}
+}
+
 func (tx *Transaction) AddSignature(publicKey PublicKey, signature []byte) TransactionInterface {
 	tx._RequireOneNodeAccountID()
 
@@ -904,6 +1370,123 @@ func (tx *Transaction) AddSignature(publicKey PublicKey, signature []byte) Trans
 	return tx
 }
 
+// MergeSignatures folds the signatures of other, another serialized copy of this frozen transaction such as one
+// returned by a co-signer, into this transaction. Signatures by keys which already signed are skipped. It fails
+// with ErrSignatureMergeBodyMismatch, leaving the transaction unchanged, unless other has exactly the same body
+// bytes for every node. Any error leaves the transaction unchanged.
+func (tx *Transaction) MergeSignatures(other []byte) error {
+	if !tx.IsFrozen() {
+		return ErrTransactionIsNotFrozen
+	}
+
+	list := sdk.TransactionList{}
+	if err := protobuf.Unmarshal(other, &list); err != nil {
+		return ErrSerialization{Message: "error deserializing transaction list in MergeSignatures", Err: err}
+	}
+	if len(list.TransactionList) != tx.signedTransactions._Length() {
+		return ErrSignatureMergeBodyMismatch
+	}
+
+	// Everything is parsed before anything is merged, so a failure can't leave a partial merge behind.
+	others := make([]*services.SignedTransaction, len(list.TransactionList))
+	otherKeys := make([][]PublicKey, len(list.TransactionList))
+	for i, transaction := range list.TransactionList {
+		signed := services.SignedTransaction{}
+		if err := protobuf.Unmarshal(transaction.GetSignedTransactionBytes(), &signed); err != nil {
+			return ErrSerialization{Message: "error deserializing SignedTransactionBytes in MergeSignatures", Err: err}
+		}
+		if !bytes.Equal(signed.GetBodyBytes(), tx.signedTransactions._Get(i).(*services.SignedTransaction).GetBodyBytes()) {
+			return ErrSignatureMergeBodyMismatch
+		}
+
+		sigPairs := signed.GetSigMap().GetSigPair()
+		otherKeys[i] = make([]PublicKey, len(sigPairs))
+		for j, sigPair := range sigPairs {
+			key, err := PublicKeyFromBytes(sigPair.GetPubKeyPrefix())
+			if err != nil {
+				return err
+			}
+			otherKeys[i][j] = key
+		}
+		others[i] = &signed
+	}
+
+	for i, signed := range others {
+		current := tx.signedTransactions._Get(i).(*services.SignedTransaction)
+		if current.SigMap == nil {
+			current.SigMap = &services.SignatureMap{}
+		}
+		for j, sigPair := range signed.GetSigMap().GetSigPair() {
+			if _SigPairsContainKey(current.SigMap.SigPair, sigPair.GetPubKeyPrefix()) {
+				continue
+			}
+			current.SigMap.SigPair = append(current.SigMap.SigPair, sigPair)
+
+			if key := otherKeys[i][j]; !tx._KeyAlreadySigned(key) {
+				tx.publicKeys = append(tx.publicKeys, key)
+				tx.transactionSigners = append(tx.transactionSigners, nil)
+			}
+		}
+		tx.signedTransactions._Set(i, current)
+	}
+
+	tx.transactions = _NewLockableSlice()
+	tx.transactionIDs.locked = true
+
+	return nil
+}
+
+func _SigPairsContainKey(sigPairs []*services.SignaturePair, pubKeyPrefix []byte) bool {
+	for _, sigPair := range sigPairs {
+		if bytes.Equal(sigPair.GetPubKeyPrefix(), pubKeyPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VerifyBodiesConsistent checks that the bodies of a frozen transaction are byte-identical across all nodes once the
+// node account ID is masked out, i.e. that every node is asked to execute the same transaction. For chunked
+// transactions each chunk is checked separately.
+func (tx *Transaction) VerifyBodiesConsistent() error {
+	if !tx.IsFrozen() {
+		return ErrTransactionIsNotFrozen
+	}
+
+	nodeCount := tx.nodeAccountIDs._Length()
+	if nodeCount == 0 {
+		return nil
+	}
+
+	var first []byte
+	for i := 0; i < tx.signedTransactions._Length(); i++ {
+		body := services.TransactionBody{}
+		if err := protobuf.Unmarshal(tx.signedTransactions._Get(i).(*services.SignedTransaction).GetBodyBytes(), &body); err != nil {
+			return ErrSerialization{Message: "error deserializing BodyBytes in VerifyBodiesConsistent", Err: err}
+		}
+		body.NodeAccountID = nil
+
+		masked, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(&body)
+		if err != nil {
+			return ErrSerialization{Message: "error serializing BodyBytes in VerifyBodiesConsistent", Err: err}
+		}
+
+		if i%nodeCount == 0 {
+			first = masked
+			continue
+		}
+		if !bytes.Equal(first, masked) {
+			return ErrTransactionBodiesInconsistent{
+				NodeAccountID:      tx.nodeAccountIDs._Get(i % nodeCount).(AccountID),
+				FirstNodeAccountID: tx.nodeAccountIDs._Get(0).(AccountID),
+			}
+		}
+	}
+
+	return nil
+}
+
 // Building empty object as "default" implementation. All inhertents must implement their own implementation.
 func (tx *Transaction) build() *services.TransactionBody {
 	return &services.TransactionBody{}
@@ -914,6 +1497,11 @@ func (tx *Transaction) buildScheduled() (*services.SchedulableTransactionBody, e
 	return &services.SchedulableTransactionBody{}, nil
 }
 
+// IsSchedulable returns whether the transaction can be wrapped in a ScheduleCreateTransaction with Schedule.
+func (tx *Transaction) IsSchedulable() bool {
+	return true
+}
+
 // -------------------------------------
 
 func TransactionSign(transaction interface{}, privateKey PrivateKey) (interface{}, error) { // nolint
@@ -4320,7 +4908,7 @@ func TransactionString(transaction interface{}) (string, error) { // nolint
 	case TopicUpdateTransaction:
 		return i.String(), nil
 	case TransferTransaction:
-		return i.String(), nil
+		return i.Transaction.String(), nil
 	case *AccountCreateTransaction:
 		return i.String(), nil
 	case *AccountDeleteTransaction:
@@ -4396,7 +4984,7 @@ func TransactionString(transaction interface{}) (string, error) { // nolint
 	case *TopicUpdateTransaction:
 		return i.String(), nil
 	case *TransferTransaction:
-		return i.String(), nil
+		return i.Transaction.String(), nil
 	default:
 		return "", errors.New("(BUG) non-exhaustive switch statement")
 	}
@@ -4748,11 +5336,9 @@ func (tx *Transaction) shouldRetry(_ Executable, response interface{}) _Executio
 	return executionStateError
 }
 
-func (tx *Transaction) makeRequest() interface{} {
+func (tx *Transaction) makeRequest() (interface{}, error) {
 	index := tx.nodeAccountIDs._Length()*tx.transactionIDs.index + tx.nodeAccountIDs.index
-	built, _ := tx._BuildTransaction(index)
-
-	return built
+	return tx._BuildTransaction(index)
 }
 
 func (tx *Transaction) advanceRequest() {
@@ -4768,6 +5354,10 @@ func (tx *Transaction) mapStatusError(
 	_ Executable,
 	response interface{},
 ) error {
+	if Status(response.(*services.TransactionResponse).NodeTransactionPrecheckCode) == StatusInsufficientTxFee {
+		tx.requiredFee = response.(*services.TransactionResponse).Cost
+	}
+
 	return ErrHederaPreCheckStatus{
 		Status: Status(response.(*services.TransactionResponse).NodeTransactionPrecheckCode),
 		//NodeID: request.transaction.nodeAccountIDs,
@@ -4813,6 +5403,12 @@ func (tx *Transaction) preFreezeWith(*Client) {
 	// NO-OP
 }
 
+// validateBeforeFreeze is where transactions check their own fields before being frozen, leaving
+// validateNetworkOnIDs to only validate ID checksums. It must not make network calls.
+func (tx *Transaction) validateBeforeFreeze(*Client) error {
+	return nil
+}
+
 func (tx *Transaction) isTransaction() bool {
 	return true
 }
@@ -4823,7 +5419,7 @@ func (tx *Transaction) getTransactionIDAndMessage() (string, string) {
 
 func (tx *Transaction) regenerateID(client *Client) bool {
 	if !client.GetOperatorAccountID()._IsZero() && tx.regenerateTransactionID && !tx.transactionIDs.locked {
-		tx.transactionIDs._Set(tx.transactionIDs.index, TransactionIDGenerate(client.GetOperatorAccountID()))
+		tx.transactionIDs._Set(tx.transactionIDs.index, client._GenerateTransactionID(client.GetOperatorAccountID()))
 		return true
 	}
 	return false
@@ -4831,7 +5427,7 @@ func (tx *Transaction) regenerateID(client *Client) bool {
 
 func (tx *Transaction) execute(client *Client, e TransactionInterface) (TransactionResponse, error) {
 	if client == nil {
-		return TransactionResponse{}, errNoClientProvided
+		return TransactionResponse{}, ErrNoClientProvided
 	}
 
 	if tx.freezeError != nil {
@@ -4847,18 +5443,23 @@ func (tx *Transaction) execute(client *Client, e TransactionInterface) (Transact
 
 	transactionID := tx.transactionIDs._GetCurrent().(TransactionID)
 
-	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		tx.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+	if err := tx._AutoSignWithOperator(client, *transactionID.AccountID); err != nil {
+		return TransactionResponse{}, err
+	}
+
+	if err := client._CheckDuplicateTransactionID(transactionID, tx.GetTransactionValidDuration(), e.getLogger(client.logger)); err != nil {
+		return TransactionResponse{}, err
 	}
 
 	if tx.grpcDeadline == nil {
 		tx.grpcDeadline = client.requestTimeout
 	}
 
+	tx.requiredFee = 0
 	resp, err := _Execute(client, e)
+	if tx.requiredFee != 0 {
+		client._LearnFee(e.getName(), HbarFromTinybar(int64(tx.requiredFee)))
+	}
 
 	if err != nil {
 		return TransactionResponse{
@@ -4881,25 +5482,42 @@ func (tx *Transaction) freezeWith(client *Client, e TransactionInterface) (Trans
 		return tx, nil
 	}
 
+	if tx.freezeError != nil {
+		return tx, ErrFreezeFailed{Err: tx.freezeError}
+	}
+
 	e.preFreezeWith(client)
 
-	tx._InitFee(client)
+	tx._InitFee(client, e.getName())
+	tx._InitTransactionValidDuration(client)
 	if err := tx._InitTransactionID(client); err != nil {
-		return tx, err
+		return tx, ErrFreezeFailed{Err: err}
+	}
+
+	if err := e.validateBeforeFreeze(client); err != nil {
+		return tx, ErrFreezeFailed{Err: err}
 	}
 
 	err := e.validateNetworkOnIDs(client)
 	if err != nil {
-		return &Transaction{}, err
+		return &Transaction{}, ErrFreezeFailed{Err: err}
 	}
 	body := e.build()
 
-	return tx, _TransactionFreezeWith(tx, client, body)
+	if err := _TransactionFreezeWith(tx, client, body); err != nil {
+		return tx, ErrFreezeFailed{Err: err}
+	}
+
+	return tx, nil
 }
 
 func (tx *Transaction) schedule(e TransactionInterface) (*ScheduleCreateTransaction, error) {
 	tx._RequireNotFrozen()
 
+	if !e.IsSchedulable() {
+		return nil, ErrTransactionNotSchedulable{Transaction: e.getName()}
+	}
+
 	scheduled, err := e.buildScheduled()
 	if err != nil {
 		return nil, err
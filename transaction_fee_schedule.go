@@ -36,7 +36,7 @@ type TransactionFeeSchedule struct {
 
 func _TransactionFeeScheduleFromProtobuf(txFeeSchedule *services.TransactionFeeSchedule) (TransactionFeeSchedule, error) {
 	if txFeeSchedule == nil {
-		return TransactionFeeSchedule{}, errParameterNull
+		return TransactionFeeSchedule{}, ErrParameterNull
 	}
 
 	feeData := make([]*FeeData, 0)
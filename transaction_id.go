@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -105,6 +106,35 @@ func (id TransactionID) String() string {
 	return returnString
 }
 
+// MarshalJSON encodes the TransactionID as a JSON string in the form returned by String, including the
+// scheduled flag and nonce.
+func (id TransactionID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.String())
+}
+
+// UnmarshalJSON decodes a TransactionID from a JSON string in the form returned by String. An empty string
+// decodes to the zero TransactionID.
+func (id *TransactionID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		*id = TransactionID{}
+		return nil
+	}
+
+	transactionID, err := TransactionIdFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*id = transactionID
+
+	return nil
+}
+
 // TransactionIDFromString constructs a TransactionID from a string representation
 func TransactionIdFromString(data string) (TransactionID, error) { // nolint
 	parts := strings.SplitN(data, "/", 2)
@@ -219,7 +249,7 @@ func (id TransactionID) ToBytes() []byte {
 // TransactionIDFromBytes constructs a TransactionID from a byte array
 func TransactionIDFromBytes(data []byte) (TransactionID, error) {
 	if data == nil {
-		return TransactionID{}, errByteArrayNull
+		return TransactionID{}, ErrByteArrayNull
 	}
 	pb := services.TransactionID{}
 	err := protobuf.Unmarshal(data, &pb)
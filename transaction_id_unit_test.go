@@ -24,8 +24,10 @@ package hedera
  */
 
 import (
+	"encoding/json"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -102,3 +104,37 @@ func TestUnitConcurrentTransactionIDsAreUnique(t *testing.T) {
 
 	require.Equal(t, len(seen), numOfTxns)
 }
+
+func TestUnitTransactionIDJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		ID    TransactionID  `json:"id"`
+		Other *TransactionID `json:"other,omitempty"`
+	}
+
+	validStart := time.Unix(1712000000, 123456789)
+	scheduled := NewTransactionIDWithValidStart(AccountID{Account: 1234}, validStart).
+		SetScheduled(true).
+		SetNonce(7)
+	plain := NewTransactionIDWithValidStart(AccountID{Account: 5}, validStart)
+
+	data, err := json.Marshal(record{ID: scheduled, Other: &plain})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"0.0.1234@1712000000.123456789?scheduled/7","other":"0.0.5@1712000000.123456789"}`, string(data))
+
+	var decoded record
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, scheduled.String(), decoded.ID.String())
+	require.True(t, decoded.ID.GetScheduled())
+	require.Equal(t, int32(7), *decoded.ID.Nonce)
+	require.True(t, decoded.ID.ValidStart.Equal(validStart))
+	require.Equal(t, plain.String(), decoded.Other.String())
+
+	var empty TransactionID
+	require.NoError(t, json.Unmarshal([]byte(`""`), &empty))
+	require.Nil(t, empty.AccountID)
+
+	require.Error(t, json.Unmarshal([]byte(`"not a transaction id"`), &empty))
+	require.Error(t, json.Unmarshal([]byte(`5`), &empty))
+}
@@ -35,6 +35,7 @@ import (
 type TransactionReceipt struct {
 	Status                  Status
 	ExchangeRate            *ExchangeRate
+	NextExchangeRate        *ExchangeRate
 	TopicID                 *TopicID
 	FileID                  *FileID
 	ContractID              *ContractID
@@ -143,6 +144,12 @@ func _TransactionReceiptFromProtobuf(protoResponse *services.TransactionGetRecei
 		rate = &exchangeRateValue
 	}
 
+	var nextRate *ExchangeRate
+	if protoReceipt.ExchangeRate.GetNextRate() != nil {
+		nextExchangeRateValue := _ExchangeRateFromProtobuf(protoReceipt.ExchangeRate.GetNextRate())
+		nextRate = &nextExchangeRateValue
+	}
+
 	var topicSequenceHash []byte
 	if protoReceipt.TopicRunningHash != nil {
 		topicHash := protoReceipt.TopicRunningHash
@@ -182,6 +189,7 @@ func _TransactionReceiptFromProtobuf(protoResponse *services.TransactionGetRecei
 	return TransactionReceipt{
 		Status:                  Status(protoReceipt.Status),
 		ExchangeRate:            rate,
+		NextExchangeRate:        nextRate,
 		TopicID:                 topicID,
 		FileID:                  fileID,
 		ContractID:              contractID,
@@ -215,6 +223,9 @@ func (receipt TransactionReceipt) _ToProtobuf() *services.TransactionGetReceiptR
 			CurrentRate: receipt.ExchangeRate._ToProtobuf(),
 			NextRate:    receipt.ExchangeRate._ToProtobuf(),
 		}
+		if receipt.NextExchangeRate != nil {
+			receiptFinal.ExchangeRate.NextRate = receipt.NextExchangeRate._ToProtobuf()
+		}
 	}
 
 	if receipt.TopicID != nil {
@@ -291,7 +302,7 @@ func (receipt TransactionReceipt) ToBytes() []byte {
 // TransactionReceiptFromBytes returns the receipt from the byte representation
 func TransactionReceiptFromBytes(data []byte) (TransactionReceipt, error) {
 	if data == nil {
-		return TransactionReceipt{}, errByteArrayNull
+		return TransactionReceipt{}, ErrByteArrayNull
 	}
 	pb := services.TransactionGetReceiptResponse{}
 	err := protobuf.Unmarshal(data, &pb)
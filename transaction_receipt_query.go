@@ -21,6 +21,8 @@ package hedera
  */
 
 import (
+	"context"
+	"errors"
 	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -34,10 +36,13 @@ import (
 // State proof is available for this response
 type TransactionReceiptQuery struct {
 	Query
-	transactionID *TransactionID
-	childReceipts *bool
-	duplicates    *bool
-	timestamp     time.Time
+	transactionID  *TransactionID
+	childReceipts  *bool
+	duplicates     *bool
+	timestamp      time.Time
+	timeout        *time.Duration
+	timedOut       bool
+	validateStatus bool
 }
 
 // NewTransactionReceiptQuery creates TransactionReceiptQuery which
@@ -96,15 +101,52 @@ func (q *TransactionReceiptQuery) GetIncludeDuplicates() bool {
 	return false
 }
 
+// SetTimeout sets how long the query keeps polling for the receipt in total, across all attempts.
+// This is separate from the gRPC deadline, which only bounds a single attempt. The timeout is only checked
+// when a response arrives, so polling can run past it by a backoff delay plus the time of one more attempt.
+// Use ExecuteWithContext with a context deadline for a hard limit.
+func (q *TransactionReceiptQuery) SetTimeout(timeout time.Duration) *TransactionReceiptQuery {
+	q.timeout = &timeout
+	return q
+}
+
+// GetTimeout returns how long the query keeps polling for the receipt in total, or 0 if there is no timeout.
+func (q *TransactionReceiptQuery) GetTimeout() time.Duration {
+	if q.timeout != nil {
+		return *q.timeout
+	}
+
+	return 0
+}
+
+// SetValidateStatus sets whether Execute returns an ErrHederaReceiptStatus alongside the receipt when the
+// receipt's status is not SUCCESS. It defaults to false, so failing receipts are returned without an error;
+// TransactionResponse.GetReceipt sets it from the response's ValidateStatus.
+func (q *TransactionReceiptQuery) SetValidateStatus(validate bool) *TransactionReceiptQuery {
+	q.validateStatus = validate
+	return q
+}
+
+// GetValidateStatus returns whether Execute returns an error for receipts whose status is not SUCCESS.
+func (q *TransactionReceiptQuery) GetValidateStatus() bool {
+	return q.validateStatus
+}
+
 func (q *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
 	return q.Query.getCost(client, q)
 }
 
 // Execute executes the Query with the provided client
 func (q *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query like Execute, but stops polling for the receipt as soon as ctx is
+// canceled or its deadline passes, returning ctx.Err().
+func (q *TransactionReceiptQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
 	// TODO(Toni): Custom execute here, should be checked against the common execute
 	if client == nil {
-		return TransactionReceipt{}, errNoClientProvided
+		return TransactionReceipt{}, ErrNoClientProvided
 	}
 
 	var err error
@@ -115,6 +157,7 @@ func (q *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, e
 	}
 
 	q.timestamp = time.Now()
+	q.timedOut = false
 
 	q.paymentTransactions = make([]*services.Transaction, 0)
 
@@ -125,17 +168,32 @@ func (q *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, e
 	}
 	q.pbHeader.ResponseType = services.ResponseType_ANSWER_ONLY
 
-	resp, err := _Execute(client, q)
+	resp, err := _ExecuteWithContext(ctx, client, q)
 
-	if err, ok := err.(ErrHederaPreCheckStatus); ok {
+	if err != nil && q.timedOut {
+		err = ErrReceiptTimeout{
+			Elapsed: time.Since(q.timestamp),
+			Timeout: *q.timeout,
+			Err:     err,
+		}
+	}
+
+	if err != nil {
+		var precheckErr ErrHederaPreCheckStatus
+		if !errors.As(err, &precheckErr) {
+			return TransactionReceipt{}, err
+		}
 		if resp.(*services.Response).GetTransactionGetReceipt() != nil {
 			return _TransactionReceiptFromProtobuf(resp.(*services.Response).GetTransactionGetReceipt(), q.transactionID), err
 		}
 		// Manually add the receipt status, because an empty receipt has no status and no status defaults to 0, which means success
-		return TransactionReceipt{Status: err.Status}, err
+		return TransactionReceipt{Status: precheckErr.Status}, err
 	}
 
-	return _TransactionReceiptFromProtobuf(resp.(*services.Response).GetTransactionGetReceipt(), q.transactionID), nil
+	q.responseHeader = _ResponseHeaderFromProtobuf(resp.(*services.Response).GetTransactionGetReceipt().GetHeader())
+	receipt := _TransactionReceiptFromProtobuf(resp.(*services.Response).GetTransactionGetReceipt(), q.transactionID)
+
+	return receipt, receipt.ValidateStatus(q.validateStatus)
 }
 
 // SetTransactionID sets the TransactionID for which the receipt is being requested.
@@ -171,6 +229,12 @@ func (q *TransactionReceiptQuery) SetMaxQueryPayment(queryMaxPayment Hbar) *Tran
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *TransactionReceiptQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *TransactionReceiptQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
 // SetMaxRetry sets the max number of errors before execution will fail.
 func (q *TransactionReceiptQuery) SetMaxRetry(count int) *TransactionReceiptQuery {
 	q.Query.SetMaxRetry(count)
@@ -184,6 +248,12 @@ func (q *TransactionReceiptQuery) SetMaxBackoff(max time.Duration) *TransactionR
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *TransactionReceiptQuery) SetSingleNode(singleNode bool) *TransactionReceiptQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *TransactionReceiptQuery) SetMinBackoff(min time.Duration) *TransactionReceiptQuery {
 	q.Query.SetMinBackoff(min)
@@ -234,7 +304,7 @@ func (q *TransactionReceiptQuery) buildQuery() *services.Query {
 		Header: q.pbHeader,
 	}
 
-	if q.transactionID.AccountID != nil {
+	if q.transactionID != nil && q.transactionID.AccountID != nil {
 		body.TransactionID = q.transactionID._ToProtobuf()
 	}
 
@@ -254,7 +324,7 @@ func (q *TransactionReceiptQuery) buildQuery() *services.Query {
 }
 
 func (q *TransactionReceiptQuery) validateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.autoValidateChecksums || q.transactionID == nil || q.transactionID.AccountID == nil {
 		return nil
 	}
 
@@ -270,7 +340,7 @@ func (q *TransactionReceiptQuery) shouldRetry(_ Executable, response interface{}
 
 	switch status {
 	case StatusPlatformTransactionNotCreated, StatusBusy, StatusUnknown, StatusReceiptNotFound, StatusRecordNotFound:
-		return executionStateRetry
+		return q._RetryOrTimeout()
 	case StatusOk:
 		break
 	default:
@@ -281,12 +351,21 @@ func (q *TransactionReceiptQuery) shouldRetry(_ Executable, response interface{}
 
 	switch status {
 	case StatusBusy, StatusUnknown, StatusOk, StatusReceiptNotFound, StatusRecordNotFound:
-		return executionStateRetry
+		return q._RetryOrTimeout()
 	default:
 		return executionStateFinished
 	}
 }
 
+func (q *TransactionReceiptQuery) _RetryOrTimeout() _ExecutionState {
+	if q.timeout != nil && time.Since(q.timestamp) >= *q.timeout {
+		q.timedOut = true
+		return executionStateError
+	}
+
+	return executionStateRetry
+}
+
 func (q *TransactionReceiptQuery) getQueryResponse(response *services.Response) queryResponse {
 	return response.GetTransactionGetReceipt()
 }
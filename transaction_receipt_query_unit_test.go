@@ -24,9 +24,12 @@ package hedera
  */
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/stretchr/testify/assert"
@@ -161,6 +164,7 @@ func TestUnitTransactionReceiptNotFound(t *testing.T) {
 	receipt, err := tx.SetValidateStatus(true).GetReceipt(client)
 	require.Error(t, err)
 	require.Equal(t, "exceptional precheck status RECEIPT_NOT_FOUND", err.Error())
+	require.ErrorIs(t, err, ErrHederaPreCheckStatus{Status: StatusReceiptNotFound})
 	require.Equal(t, StatusReceiptNotFound, receipt.Status)
 }
 func TestUnitTransactionReceiptUknown(t *testing.T) {
@@ -359,6 +363,44 @@ func TestUnitTransactionReceiptToJson(t *testing.T) {
 
 }
 
+func TestUnitTransactionReceiptExchangeRate(t *testing.T) {
+	t.Parallel()
+
+	receipt := _TransactionReceiptFromProtobuf(&services.TransactionGetReceiptResponse{
+		Receipt: &services.TransactionReceipt{
+			Status: services.ResponseCodeEnum_SUCCESS,
+			ExchangeRate: &services.ExchangeRateSet{
+				CurrentRate: &services.ExchangeRate{
+					HbarEquiv:      30000,
+					CentEquiv:      154271,
+					ExpirationTime: &services.TimestampSeconds{Seconds: 1694689200},
+				},
+				NextRate: &services.ExchangeRate{
+					HbarEquiv:      30000,
+					CentEquiv:      160000,
+					ExpirationTime: &services.TimestampSeconds{Seconds: 1694692800},
+				},
+			},
+		},
+	}, nil)
+
+	require.NotNil(t, receipt.ExchangeRate)
+	require.Equal(t, int32(30000), receipt.ExchangeRate.GetHbars())
+	require.Equal(t, int32(154271), receipt.ExchangeRate.GetCents())
+	require.Equal(t, time.Unix(1694689200, 0), receipt.ExchangeRate.GetExpirationTime())
+
+	require.NotNil(t, receipt.NextExchangeRate)
+	require.Equal(t, int32(160000), receipt.NextExchangeRate.GetCents())
+	require.Equal(t, time.Unix(1694692800, 0), receipt.NextExchangeRate.GetExpirationTime())
+
+	decoded, err := TransactionReceiptFromBytes(receipt.ToBytes())
+	require.NoError(t, err)
+	require.Equal(t, int32(154271), decoded.ExchangeRate.GetCents())
+	require.Equal(t, int32(160000), decoded.NextExchangeRate.GetCents())
+
+	require.True(t, (&ExchangeRate{}).GetExpirationTime().IsZero())
+}
+
 func TestUnitTransactionResponseToJson(t *testing.T) {
 	t.Parallel()
 
@@ -398,3 +440,283 @@ func TestUnitTransactionResponseToJson(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedJSON, jsonBytes)
 }
+
+func TestUnitTransactionReceiptQueryTimeout(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	notFound := func(request *services.Query) *services.Response {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(10 * time.Millisecond)
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{
+						NodeTransactionPrecheckCode: services.ResponseCodeEnum_RECEIPT_NOT_FOUND,
+						ResponseType:                services.ResponseType_ANSWER_ONLY,
+					},
+				},
+			},
+		}
+	}
+	responses := make([]interface{}, 0, 100)
+	for i := 0; i < 100; i++ {
+		responses = append(responses, notFound)
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+	client.SetMaxAttempts(100)
+
+	query := NewTransactionReceiptQuery().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTimeout(50 * time.Millisecond)
+	require.Equal(t, 50*time.Millisecond, query.GetTimeout())
+
+	_, err := query.Execute(client)
+	require.Error(t, err)
+
+	var timeoutErr ErrReceiptTimeout
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, 50*time.Millisecond, timeoutErr.Timeout)
+	require.GreaterOrEqual(t, timeoutErr.Elapsed, 50*time.Millisecond)
+	require.ErrorIs(t, err, ErrHederaPreCheckStatus{Status: StatusReceiptNotFound})
+	// The query polled more than once and was stopped by the timeout, not by running out of attempts.
+	require.Greater(t, atomic.LoadInt32(&requests), int32(1))
+	require.Less(t, atomic.LoadInt32(&requests), int32(100))
+}
+
+func TestUnitTransactionReceiptQueryExecuteWithContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	notFound := &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{
+					NodeTransactionPrecheckCode: services.ResponseCodeEnum_RECEIPT_NOT_FOUND,
+					ResponseType:                services.ResponseType_ANSWER_ONLY,
+				},
+			},
+		},
+	}
+	responses := make([]interface{}, 0, 10)
+	for i := 0; i < 10; i++ {
+		responses = append(responses, notFound)
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+	client.SetMaxAttempts(10)
+
+	query := NewTransactionReceiptQuery().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetMinBackoff(2 * time.Second).
+		SetMaxBackoff(8 * time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := query.ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestUnitTransactionReceiptQueryValidateStatus(t *testing.T) {
+	t.Parallel()
+
+	failing := &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{
+					NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+					ResponseType:                services.ResponseType_ANSWER_ONLY,
+				},
+				Receipt: &services.TransactionReceipt{
+					Status: services.ResponseCodeEnum_INVALID_SIGNATURE,
+				},
+			},
+		},
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{failing, failing}})
+	defer server.Close()
+
+	transactionID := TransactionIDGenerate(AccountID{Account: 1800})
+
+	query := NewTransactionReceiptQuery().
+		SetTransactionID(transactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}})
+	require.False(t, query.GetValidateStatus())
+
+	receipt, err := query.Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, StatusInvalidSignature, receipt.Status)
+
+	receipt, err = NewTransactionReceiptQuery().
+		SetTransactionID(transactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetValidateStatus(true).
+		Execute(client)
+	var statusErr ErrHederaReceiptStatus
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, StatusInvalidSignature, statusErr.Status)
+	require.Equal(t, StatusInvalidSignature, receipt.Status)
+}
+
+func TestUnitTransactionReceiptQueryIncludeChildren(t *testing.T) {
+	t.Parallel()
+
+	call := func(request *services.Query) *services.Response {
+		require.True(t, request.GetTransactionGetReceipt().GetIncludeChildReceipts())
+
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{
+						NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+						ResponseType:                services.ResponseType_ANSWER_ONLY,
+					},
+					Receipt: &services.TransactionReceipt{
+						Status:     services.ResponseCodeEnum_SUCCESS,
+						ContractID: &services.ContractID{Contract: &services.ContractID_ContractNum{ContractNum: 456}},
+					},
+					ChildTransactionReceipts: []*services.TransactionReceipt{
+						{
+							Status:    services.ResponseCodeEnum_SUCCESS,
+							AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1001}},
+						},
+						{
+							Status:  services.ResponseCodeEnum_SUCCESS,
+							TokenID: &services.TokenID{TokenNum: 1002},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call}})
+	defer server.Close()
+
+	transactionID := TransactionIDGenerate(AccountID{Account: 1800})
+	receipt, err := NewTransactionReceiptQuery().
+		SetTransactionID(transactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetIncludeChildren(true).
+		Execute(client)
+	require.NoError(t, err)
+
+	require.Equal(t, ContractID{Contract: 456}, *receipt.ContractID)
+	require.Len(t, receipt.Children, 2)
+	require.Equal(t, AccountID{Account: 1001}, *receipt.Children[0].AccountID)
+	require.Equal(t, TokenID{Token: 1002}, *receipt.Children[1].TokenID)
+	for _, child := range receipt.Children {
+		require.Equal(t, StatusSuccess, child.Status)
+		require.Equal(t, transactionID.String(), child.TransactionID.String())
+	}
+}
+
+func TestUnitTransactionReceiptQueryChildrenAndDuplicatesWithChecksums(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}}).
+		SetRequestInterceptor(func(_ string, request interface{}) (interface{}, error) {
+			body := request.(*services.Query).GetTransactionGetReceipt()
+			require.True(t, body.GetIncludeChildReceipts())
+			require.True(t, body.GetIncludeDuplicates())
+
+			return &services.Response{
+				Response: &services.Response_TransactionGetReceipt{
+					TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+						Header: &services.ResponseHeader{
+							NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+							ResponseType:                services.ResponseType_ANSWER_ONLY,
+						},
+						Receipt:                      &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS},
+						ChildTransactionReceipts:     []*services.TransactionReceipt{{Status: services.ResponseCodeEnum_SUCCESS}},
+						DuplicateTransactionReceipts: []*services.TransactionReceipt{{Status: services.ResponseCodeEnum_DUPLICATE_TRANSACTION}},
+					},
+				},
+			}, nil
+		})
+	client.SetAutoValidateChecksums(true)
+
+	payer, err := AccountIDFromString("0.0.123-vfmkw")
+	require.NoError(t, err)
+	receipt, err := NewTransactionReceiptQuery().
+		SetTransactionID(TransactionIDGenerate(payer)).
+		SetIncludeChildren(true).
+		SetIncludeDuplicates(true).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, receipt.Children, 1)
+	require.Len(t, receipt.Duplicates, 1)
+	assert.Equal(t, StatusDuplicateTransaction, receipt.Duplicates[0].Status)
+
+	badPayer, err := AccountIDFromString("0.0.123-abcde")
+	require.NoError(t, err)
+	_, err = NewTransactionReceiptQuery().
+		SetTransactionID(TransactionIDGenerate(badPayer)).
+		SetIncludeChildren(true).
+		SetIncludeDuplicates(true).
+		Execute(client)
+	require.Error(t, err)
+}
+
+func TestUnitTransactionResponseGetReceiptWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	receiptResponse := func(status services.ResponseCodeEnum) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{
+						NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+						ResponseType:                services.ResponseType_ANSWER_ONLY,
+					},
+					Receipt: &services.TransactionReceipt{Status: status},
+				},
+			},
+		}
+	}
+
+	pending := make([]interface{}, 0)
+	for i := 0; i < 12; i++ {
+		pending = append(pending, receiptResponse(services.ResponseCodeEnum_UNKNOWN))
+	}
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}}).
+		SetRequestInterceptor(NewCannedResponseInterceptor(map[string][]interface{}{
+			"TransactionReceiptQuery": append(pending, receiptResponse(services.ResponseCodeEnum_SUCCESS)),
+		}))
+	client.SetMinBackoff(time.Millisecond)
+	client.SetMaxBackoff(time.Millisecond)
+	// The client's max attempts runs out several times before the receipt is available.
+	client.SetMaxAttempts(2)
+
+	response := TransactionResponse{
+		TransactionID:  TransactionIDGenerate(AccountID{Account: 1800}),
+		NodeID:         AccountID{Account: 3},
+		ValidateStatus: true,
+	}
+
+	receipt, err := response.GetReceiptWithTimeout(client, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, receipt.Status)
+
+	notFound := make([]interface{}, 0)
+	for i := 0; i < 1000; i++ {
+		notFound = append(notFound, receiptResponse(services.ResponseCodeEnum_RECEIPT_NOT_FOUND))
+	}
+	client.SetRequestInterceptor(NewCannedResponseInterceptor(map[string][]interface{}{
+		"TransactionReceiptQuery": notFound,
+	}))
+
+	_, err = response.GetReceiptWithTimeout(client, 20*time.Millisecond)
+	var timeoutErr ErrReceiptTimeout
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, 20*time.Millisecond, timeoutErr.Timeout)
+	require.ErrorIs(t, err, ErrHederaPreCheckStatus{Status: StatusReceiptNotFound})
+}
@@ -539,7 +539,7 @@ func (record TransactionRecord) ToBytes() []byte {
 // TransactionRecordFromBytes returns a TransactionRecord from a raw protobuf byte array
 func TransactionRecordFromBytes(data []byte) (TransactionRecord, error) {
 	if data == nil {
-		return TransactionRecord{}, errByteArrayNull
+		return TransactionRecord{}, ErrByteArrayNull
 	}
 	pb := services.TransactionGetRecordResponse{}
 	err := protobuf.Unmarshal(data, &pb)
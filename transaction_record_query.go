@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"errors"
 	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -39,6 +40,7 @@ type TransactionRecordQuery struct {
 	transactionID       *TransactionID
 	includeChildRecords *bool
 	duplicates          *bool
+	validateStatus      bool
 }
 
 // NewTransactionRecordQuery creates TransactionRecordQuery which
@@ -52,7 +54,8 @@ type TransactionRecordQuery struct {
 func NewTransactionRecordQuery() *TransactionRecordQuery {
 	header := services.QueryHeader{}
 	return &TransactionRecordQuery{
-		Query: _NewQuery(true, &header),
+		Query:          _NewQuery(true, &header),
+		validateStatus: true,
 	}
 }
 
@@ -108,13 +111,29 @@ func (q *TransactionRecordQuery) Execute(client *Client) (TransactionRecord, err
 	resp, err := q.Query.execute(client, q)
 
 	if err != nil {
-		if precheckErr, ok := err.(ErrHederaPreCheckStatus); ok {
+		var precheckErr ErrHederaPreCheckStatus
+		if errors.As(err, &precheckErr) {
 			return TransactionRecord{}, _NewErrHederaReceiptStatus(precheckErr.TxID, precheckErr.Status)
 		}
 		return TransactionRecord{}, err
 	}
 
-	return _TransactionRecordFromProtobuf(resp.GetTransactionGetRecord(), q.transactionID), nil
+	record := _TransactionRecordFromProtobuf(resp.GetTransactionGetRecord(), q.transactionID)
+
+	return record, record.ValidateReceiptStatus(q.validateStatus)
+}
+
+// SetValidateStatus sets whether Execute returns an ErrHederaReceiptStatus alongside the record when the
+// record's receipt status is not SUCCESS. It defaults to true; set it to false to get records of failed
+// transactions without an error and inspect their status yourself.
+func (q *TransactionRecordQuery) SetValidateStatus(validate bool) *TransactionRecordQuery {
+	q.validateStatus = validate
+	return q
+}
+
+// GetValidateStatus returns whether Execute returns an error for records whose receipt status is not SUCCESS.
+func (q *TransactionRecordQuery) GetValidateStatus() bool {
+	return q.validateStatus
 }
 
 // SetTransactionID sets the TransactionID for this TransactionRecordQuery.
@@ -150,6 +169,19 @@ func (q *TransactionRecordQuery) SetMaxQueryPayment(queryMaxPayment Hbar) *Trans
 	return q
 }
 
+// SetPaymentTransactionMaxFee sets the max transaction fee of the payment transaction generated for this query.
+func (q *TransactionRecordQuery) SetPaymentTransactionMaxFee(maxFee Hbar) *TransactionRecordQuery {
+	q.Query.SetPaymentTransactionMaxFee(maxFee)
+	return q
+}
+
+// SetRequestStateProof asks the node to return a state proof alongside the answer, available from
+// GetResponseHeader after execution.
+func (q *TransactionRecordQuery) SetRequestStateProof(request bool) *TransactionRecordQuery {
+	q.Query.SetRequestStateProof(request)
+	return q
+}
+
 // SetMaxRetry sets the max number of errors before execution will fail.
 func (q *TransactionRecordQuery) SetMaxRetry(count int) *TransactionRecordQuery {
 	q.Query.SetMaxRetry(count)
@@ -163,6 +195,12 @@ func (q *TransactionRecordQuery) SetMaxBackoff(max time.Duration) *TransactionRe
 	return q
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (q *TransactionRecordQuery) SetSingleNode(singleNode bool) *TransactionRecordQuery {
+	q.Query.SetSingleNode(singleNode)
+	return q
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (q *TransactionRecordQuery) SetMinBackoff(min time.Duration) *TransactionRecordQuery {
 	q.Query.SetMinBackoff(min)
@@ -253,7 +291,8 @@ func (q *TransactionRecordQuery) shouldRetry(_ Executable, response interface{})
 	case StatusPlatformTransactionNotCreated, StatusBusy, StatusUnknown, StatusReceiptNotFound, StatusRecordNotFound:
 		return executionStateRetry
 	case StatusOk:
-		if response.(*services.Response).GetTransactionGetRecord().GetHeader().ResponseType == services.ResponseType_COST_ANSWER {
+		switch response.(*services.Response).GetTransactionGetRecord().GetHeader().ResponseType {
+		case services.ResponseType_COST_ANSWER, services.ResponseType_COST_ANSWER_STATE_PROOF:
 			return executionStateFinished
 		}
 	default:
@@ -265,10 +304,8 @@ func (q *TransactionRecordQuery) shouldRetry(_ Executable, response interface{})
 	switch status {
 	case StatusBusy, StatusUnknown, StatusOk, StatusReceiptNotFound, StatusRecordNotFound:
 		return executionStateRetry
-	case StatusSuccess:
-		return executionStateFinished
 	default:
-		return executionStateError
+		return executionStateFinished
 	}
 }
 
@@ -169,6 +169,7 @@ func TestUnitTransactionRecordReceiptNotFound(t *testing.T) {
 	record, err := tx.SetValidateStatus(true).GetRecord(client)
 	require.Error(t, err)
 	require.Equal(t, "exceptional precheck status RECEIPT_NOT_FOUND", err.Error())
+	require.ErrorIs(t, err, ErrHederaPreCheckStatus{Status: StatusReceiptNotFound})
 	require.Equal(t, StatusReceiptNotFound, record.Receipt.Status)
 }
 
@@ -258,3 +259,271 @@ func TestUnitTransactionRecordQueryMarshalJSON(t *testing.T) {
 	{"accountId":"0.0.1157","amount":"-1041694270","isApproved":false},{"accountId":"0.0.1246","amount":"1000000000","isApproved":false}]}`
 	require.JSONEqf(t, expected, string(result), "json should be equal")
 }
+
+func TestUnitTransactionResponseGetTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{
+						ResponseType: services.ResponseType_ANSWER_ONLY,
+					},
+					Receipt: &services.TransactionReceipt{
+						Status: services.ResponseCodeEnum_SUCCESS,
+					},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						ResponseType: services.ResponseType_COST_ANSWER,
+						Cost:         1,
+					},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						ResponseType: services.ResponseType_ANSWER_ONLY,
+					},
+					TransactionRecord: &services.TransactionRecord{
+						Receipt: &services.TransactionReceipt{
+							Status: services.ResponseCodeEnum_SUCCESS,
+						},
+						TransactionFee: 84_217,
+					},
+				},
+			},
+		},
+	}}
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetMaxTransactionFee(NewHbar(1)).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	fee, err := tx.GetTransactionFee(client)
+	require.NoError(t, err)
+	require.Equal(t, HbarFromTinybar(84_217), fee)
+}
+
+func TestUnitTransactionResponseGetConsensusTimestamp(t *testing.T) {
+	t.Parallel()
+
+	consensusTimestamp := time.Unix(1_700_000_000, 123_456_789)
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{
+						ResponseType: services.ResponseType_ANSWER_ONLY,
+					},
+					Receipt: &services.TransactionReceipt{
+						Status: services.ResponseCodeEnum_SUCCESS,
+					},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						ResponseType: services.ResponseType_COST_ANSWER,
+						Cost:         1,
+					},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						ResponseType: services.ResponseType_ANSWER_ONLY,
+					},
+					TransactionRecord: &services.TransactionRecord{
+						Receipt: &services.TransactionReceipt{
+							Status: services.ResponseCodeEnum_SUCCESS,
+						},
+						ConsensusTimestamp: _TimeToProtobuf(consensusTimestamp),
+					},
+				},
+			},
+		},
+	}}
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	tx, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	timestamp, err := tx.GetConsensusTimestamp(client)
+	require.NoError(t, err)
+	require.True(t, consensusTimestamp.Equal(timestamp))
+}
+
+func TestUnitTransactionResponseGetRecordOrReceipt(t *testing.T) {
+	t.Parallel()
+
+	receipt := &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{
+					ResponseType: services.ResponseType_ANSWER_ONLY,
+				},
+				Receipt: &services.TransactionReceipt{
+					Status: services.ResponseCodeEnum_SUCCESS,
+				},
+			},
+		},
+	}
+	responses := [][]interface{}{{
+		receipt,
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						ResponseType: services.ResponseType_COST_ANSWER,
+						Cost:         1,
+					},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						ResponseType: services.ResponseType_ANSWER_ONLY,
+					},
+					TransactionRecord: &services.TransactionRecord{
+						Receipt: &services.TransactionReceipt{
+							Status: services.ResponseCodeEnum_SUCCESS,
+						},
+						TransferList: &services.TransferList{
+							AccountAmounts: []*services.AccountAmount{
+								{AccountID: AccountID{Account: 2}._ToProtobuf(), Amount: -1},
+								{AccountID: AccountID{Account: 3}._ToProtobuf(), Amount: 1},
+							},
+						},
+					},
+				},
+			},
+		},
+		receipt,
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{
+						NodeTransactionPrecheckCode: services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE,
+						ResponseType:                services.ResponseType_COST_ANSWER,
+					},
+				},
+			},
+		},
+	}}
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	response := TransactionResponse{
+		TransactionID:  TransactionIDGenerate(AccountID{Account: 1800}),
+		NodeID:         AccountID{Account: 3},
+		ValidateStatus: true,
+	}
+
+	outcome, err := response.GetRecordOrReceipt(client)
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, outcome.Status)
+	require.NotNil(t, outcome.Record)
+	require.Equal(t, []Transfer{
+		{AccountID: AccountID{Account: 2}, Amount: HbarFromTinybar(-1)},
+		{AccountID: AccountID{Account: 3}, Amount: HbarFromTinybar(1)},
+	}, outcome.Transfers)
+
+	outcome, err = response.GetRecordOrReceipt(client)
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, outcome.Status)
+	require.Equal(t, StatusSuccess, outcome.Receipt.Status)
+	require.Nil(t, outcome.Record)
+	require.Empty(t, outcome.Transfers)
+}
+
+func TestUnitTransactionResponseGetRecordFailedStatus(t *testing.T) {
+	t.Parallel()
+
+	failed := &services.TransactionReceipt{Status: services.ResponseCodeEnum_INSUFFICIENT_ACCOUNT_BALANCE}
+	recordCost := &services.Response{
+		Response: &services.Response_TransactionGetRecord{
+			TransactionGetRecord: &services.TransactionGetRecordResponse{
+				Header: &services.ResponseHeader{ResponseType: services.ResponseType_COST_ANSWER, Cost: 1},
+			},
+		},
+	}
+	record := &services.Response{
+		Response: &services.Response_TransactionGetRecord{
+			TransactionGetRecord: &services.TransactionGetRecordResponse{
+				Header: &services.ResponseHeader{ResponseType: services.ResponseType_ANSWER_ONLY},
+				TransactionRecord: &services.TransactionRecord{
+					Receipt:        failed,
+					TransactionFee: 5,
+				},
+			},
+		},
+	}
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{ResponseType: services.ResponseType_ANSWER_ONLY},
+					Receipt: &services.TransactionReceipt{
+						Status: services.ResponseCodeEnum_UNKNOWN,
+					},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header:  &services.ResponseHeader{ResponseType: services.ResponseType_ANSWER_ONLY},
+					Receipt: failed,
+				},
+			},
+		},
+		recordCost,
+		record,
+	}}
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	response := TransactionResponse{
+		TransactionID:  TransactionIDGenerate(AccountID{Account: 1800}),
+		NodeID:         AccountID{Account: 3},
+		ValidateStatus: true,
+	}
+
+	result, err := response.GetRecord(client)
+	var statusErr ErrHederaReceiptStatus
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, StatusInsufficientAccountBalance, statusErr.Status)
+	require.Equal(t, StatusInsufficientAccountBalance, result.Receipt.Status)
+	require.Equal(t, HbarFromTinybar(5), result.TransactionFee)
+}
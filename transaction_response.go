@@ -1,7 +1,10 @@
 package hedera
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -39,6 +42,15 @@ type TransactionResponse struct {
 	ValidateStatus         bool
 }
 
+// TransactionOutcome is the result of TransactionResponse.GetRecordOrReceipt. Record is nil when the record
+// could not be fetched and only the receipt is known, in which case Transfers is empty as well.
+type TransactionOutcome struct {
+	Status    Status
+	Receipt   TransactionReceipt
+	Record    *TransactionRecord
+	Transfers []Transfer
+}
+
 // MarshalJSON returns the JSON representation of the TransactionResponse.
 // This should yield the same result in all SDK's.
 func (response TransactionResponse) MarshalJSON() ([]byte, error) {
@@ -52,19 +64,47 @@ func (response TransactionResponse) MarshalJSON() ([]byte, error) {
 
 // GetReceipt retrieves the receipt for the transaction
 func (response TransactionResponse) GetReceipt(client *Client) (TransactionReceipt, error) {
-	receipt, err := NewTransactionReceiptQuery().
+	return NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
+		SetValidateStatus(response.ValidateStatus).
 		Execute(client)
+}
 
-	if err != nil {
-		return receipt, err
-	}
+// GetReceiptWithTimeout retrieves the receipt like GetReceipt, but keeps polling while the receipt is not yet
+// available (RECEIPT_NOT_FOUND, UNKNOWN or BUSY) until timeout has elapsed. When the query runs out of attempts
+// first, including a limit set with Client.SetMaxAttempts, it is run again, so only timeout ends the polling.
+// If the receipt is still unavailable when timeout elapses, the error is an ErrReceiptTimeout.
+func (response TransactionResponse) GetReceiptWithTimeout(client *Client, timeout time.Duration) (TransactionReceipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	query := NewTransactionReceiptQuery().
+		SetTransactionID(response.TransactionID).
+		SetNodeAccountIDs([]AccountID{response.NodeID}).
+		SetValidateStatus(response.ValidateStatus)
 
-	return receipt, receipt.ValidateStatus(response.ValidateStatus)
+	start := time.Now()
+	var lastErr error
+	for {
+		receipt, err := query.ExecuteWithContext(ctx, client)
+		if ctx.Err() != nil {
+			if lastErr == nil {
+				lastErr = err
+			}
+			return receipt, ErrReceiptTimeout{Elapsed: time.Since(start), Timeout: timeout, Err: lastErr}
+		}
+
+		var attemptsErr ErrMaxAttemptsExceeded
+		if !errors.As(err, &attemptsErr) {
+			return receipt, err
+		}
+		lastErr = err
+	}
 }
 
-// GetRecord retrieves the record for the transaction
+// GetRecord waits for the transaction's receipt and then retrieves its record. When ValidateStatus is set and the
+// transaction did not succeed, the record is still returned, alongside an ErrHederaReceiptStatus.
 func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
@@ -79,7 +119,62 @@ func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord
 	return NewTransactionRecordQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
+		SetValidateStatus(response.ValidateStatus).
+		Execute(client)
+}
+
+// GetRecordOrReceipt retrieves the record for the transaction and falls back to its receipt when the record can't
+// be fetched, e.g. because the operator can't pay for the record query or the record is no longer available.
+// Like GetReceipt, it returns an ErrHederaReceiptStatus alongside the outcome when ValidateStatus is set and
+// the transaction did not succeed.
+func (response TransactionResponse) GetRecordOrReceipt(client *Client) (TransactionOutcome, error) {
+	receipt, err := NewTransactionReceiptQuery().
+		SetTransactionID(response.TransactionID).
+		SetNodeAccountIDs([]AccountID{response.NodeID}).
+		SetValidateStatus(false).
+		Execute(client)
+	if err != nil {
+		return TransactionOutcome{Status: receipt.Status, Receipt: receipt}, err
+	}
+
+	outcome := TransactionOutcome{
+		Status:  receipt.Status,
+		Receipt: receipt,
+	}
+
+	record, err := NewTransactionRecordQuery().
+		SetTransactionID(response.TransactionID).
+		SetNodeAccountIDs([]AccountID{response.NodeID}).
+		SetValidateStatus(false).
 		Execute(client)
+	if err == nil {
+		outcome.Record = &record
+		outcome.Transfers = record.Transfers
+	}
+
+	return outcome, receipt.ValidateStatus(response.ValidateStatus)
+}
+
+// GetTransactionFee retrieves the record for the transaction and returns the fee which was actually charged,
+// as opposed to the max transaction fee which was set on the transaction.
+func (response TransactionResponse) GetTransactionFee(client *Client) (Hbar, error) {
+	record, err := response.GetRecord(client)
+	if err != nil {
+		return Hbar{}, err
+	}
+
+	return record.TransactionFee, nil
+}
+
+// GetConsensusTimestamp retrieves the record for the transaction and returns only its consensus timestamp,
+// which the receipt does not carry.
+func (response TransactionResponse) GetConsensusTimestamp(client *Client) (time.Time, error) {
+	record, err := response.GetRecord(client)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return record.ConsensusTimestamp, nil
 }
 
 // GetReceiptQuery retrieves the receipt query for the transaction
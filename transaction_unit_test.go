@@ -24,8 +24,13 @@ package hedera
  */
 
 import (
+	"bytes"
+	"context"
+	"encoding/hex"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -610,7 +615,7 @@ func TestUnitTransactionAttributes(t *testing.T) {
 	client, err := _NewMockClient()
 	require.NoError(t, err)
 	client.SetLedgerID(*NewLedgerIDTestnet())
-	nodeAccountIds := client.network._GetNodeAccountIDsForExecute()
+	nodeAccountIds := client._NodeAccountIDsForExecute()
 
 	txs := []interface{}{
 		NewAccountCreateTransaction(),
@@ -677,7 +682,7 @@ func TestUnitTransactionAttributesDereferanced(t *testing.T) {
 	client, err := _NewMockClient()
 	require.NoError(t, err)
 	client.SetLedgerID(*NewLedgerIDTestnet())
-	nodeAccountIds := client.network._GetNodeAccountIDsForExecute()
+	nodeAccountIds := client._NodeAccountIDsForExecute()
 
 	txs := []interface{}{
 		NewAccountCreateTransaction(),
@@ -832,7 +837,7 @@ func signSwitchCaseaSetup(t *testing.T) (PrivateKey, *Client, AccountID) {
 	require.NoError(t, err)
 	client.SetLedgerID(*NewLedgerIDTestnet())
 
-	nodeAccountIds := client.network._GetNodeAccountIDsForExecute()
+	nodeAccountIds := client._NodeAccountIDsForExecute()
 	nodeAccountId := nodeAccountIds[0]
 
 	return newKey, client, nodeAccountId
@@ -1005,3 +1010,1083 @@ func createTransactionTests(txName string, nodeAccountIds []AccountID) []transac
 // TransactionGetTransactionHash //needs to be tested in e2e tests
 // TransactionGetTransactionHashPerNode //needs to be tested in e2e tests
 // TransactionExecute //needs to be tested in e2e tests
+
+func TestUnitTransactionGetRemainingSignaturesThresholdKey(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]PrivateKey, 3)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	keyList := KeyListWithThreshold(2).
+		AddAllPublicKeys([]PublicKey{keys[0].PublicKey(), keys[1].PublicKey(), keys[2].PublicKey()})
+
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1234})).
+		AddHbarTransfer(AccountID{Account: 1234}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+	require.Equal(t, 2, transaction.GetRemainingSignatures(keyList))
+
+	transaction.Sign(keys[0])
+	require.Equal(t, 1, transaction.GetRemainingSignatures(keyList))
+	require.Equal(t, 0, transaction.GetRemainingSignatures(keys[0].PublicKey()))
+	require.Equal(t, 1, transaction.GetRemainingSignatures(keys[2].PublicKey()))
+
+	transaction.SignWith(keys[2].PublicKey(), keys[2].Sign)
+	require.Equal(t, 0, transaction.GetRemainingSignatures(keyList))
+
+	nested := NewKeyList().Add(keys[1].PublicKey()).Add(keyList)
+	require.Equal(t, 1, nested.RemainingSignatures([]PublicKey{keys[0].PublicKey(), keys[2].PublicKey()}))
+	require.Equal(t, 0, nested.RemainingSignatures([]PublicKey{keys[0].PublicKey(), keys[1].PublicKey()}))
+}
+
+func TestUnitTransactionFromMirrorBytes(t *testing.T) {
+	t.Parallel()
+
+	// SignedTransaction of a 5 hbar transfer from 0.0.1800 to 0.0.1234 with the memo "sweep", signed by mockPrivateKey
+	mirrorBytes, err := hex.DecodeString("0a410a0d0a0608808fb2af06120318880e120218031880c2d72f2202087832057377656570721c0a1a0a0b0a0318d209108094ebdc030a0b0a0318880e10ff93ebdc0312660a640a20e4f1c0eb4c7dcdc3e7eb1170b3088a3d12a297f4a3ebe2f28503fd673546ed8e1a40778d7008d0131256ce55f1b7a528e86fae6dac9d3c079dd65121015e1743dd61175353fe9c4b89f077989854741667c489fc1d79a997aa63956091f4e7169e0f")
+	require.NoError(t, err)
+
+	tx, err := TransactionFromMirrorBytes(mirrorBytes)
+	require.NoError(t, err)
+
+	transfer, ok := tx.(TransferTransaction)
+	require.True(t, ok)
+	require.Equal(t, map[AccountID]Hbar{
+		{Account: 1800}: NewHbar(-5),
+		{Account: 1234}: NewHbar(5),
+	}, transfer.GetHbarTransfers())
+	require.Equal(t, "sweep", transfer.GetTransactionMemo())
+	require.Equal(t, AccountID{Account: 1800}, *transfer.GetTransactionID().AccountID)
+	require.Equal(t, []AccountID{{Account: 3}}, transfer.GetNodeAccountIDs())
+
+	key, err := PrivateKeyFromStringEd25519(mockPrivateKey)
+	require.NoError(t, err)
+	require.True(t, key.PublicKey().VerifyTransaction(transfer.Transaction))
+
+	_, err = TransactionFromMirrorBytes([]byte{})
+	require.ErrorIs(t, err, ErrNoTransactionInBytes)
+}
+
+func TestUnitTransactionSingleNodeDoesNotAdvance(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}, {}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	transaction := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetSingleNode(true).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1))
+	require.True(t, transaction.GetSingleNode())
+
+	response, err := transaction.Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 3}, response.NodeID)
+
+	balance, err := NewAccountBalanceQuery().
+		SetAccountID(AccountID{Account: 1800}).
+		SetNodeAccountIDs([]AccountID{{Account: 4}, {Account: 3}}).
+		SetSingleNode(true).
+		Execute(client)
+	require.Error(t, err)
+	require.Equal(t, Hbar{}, balance.Hbars)
+	var networkErr ErrHederaNetwork
+	require.ErrorAs(t, err, &networkErr)
+}
+
+// _InFlightSigner records the largest number of Sign calls running at once.
+type _InFlightSigner struct {
+	key         PrivateKey
+	concurrency int
+	err         error
+	inFlight    int32
+	maxInFlight int32
+}
+
+func (signer *_InFlightSigner) PublicKey() PublicKey {
+	return signer.key.PublicKey()
+}
+
+func (signer *_InFlightSigner) Sign(message []byte) ([]byte, error) {
+	inFlight := atomic.AddInt32(&signer.inFlight, 1)
+	defer atomic.AddInt32(&signer.inFlight, -1)
+	for {
+		highest := atomic.LoadInt32(&signer.maxInFlight)
+		if inFlight <= highest || atomic.CompareAndSwapInt32(&signer.maxInFlight, highest, inFlight) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if signer.err != nil {
+		return nil, signer.err
+	}
+
+	return signer.key.Sign(message), nil
+}
+
+type _ConcurrentInFlightSigner struct {
+	*_InFlightSigner
+}
+
+func (signer _ConcurrentInFlightSigner) SigningConcurrency() int {
+	return signer.concurrency
+}
+
+func TestUnitTransactionSignsNodeBodiesConcurrentlyOnlyWhenOptedIn(t *testing.T) {
+	t.Parallel()
+
+	nodeAccountIDs := make([]AccountID, 8)
+	for i := range nodeAccountIDs {
+		nodeAccountIDs[i] = AccountID{Account: uint64(3 + i)}
+	}
+
+	newTransfer := func() *TransferTransaction {
+		transaction, err := NewTransferTransaction().
+			SetNodeAccountIDs(nodeAccountIDs).
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+			AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+			Freeze()
+		require.NoError(t, err)
+		return transaction
+	}
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	sequential := &_InFlightSigner{key: key}
+	transaction := newTransfer().SignWithSigner(sequential)
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&sequential.maxInFlight))
+
+	concurrent := _ConcurrentInFlightSigner{&_InFlightSigner{key: key, concurrency: 4}}
+	transaction = newTransfer().SignWithSigner(concurrent)
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+	require.Greater(t, atomic.LoadInt32(&concurrent.maxInFlight), int32(1))
+	require.LessOrEqual(t, atomic.LoadInt32(&concurrent.maxInFlight), int32(4))
+
+	signatures, err := transaction.GetSignatures()
+	require.NoError(t, err)
+	for i, nodeAccountID := range nodeAccountIDs {
+		require.Len(t, signatures[nodeAccountID], 1)
+		for _, signature := range signatures[nodeAccountID] {
+			require.True(t, key.PublicKey().Verify(transaction.GetSignedTransactionBodyBytes(i), signature))
+		}
+	}
+
+	other, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	failing := _ConcurrentInFlightSigner{&_InFlightSigner{key: other, concurrency: 4, err: fmt.Errorf("hsm unavailable")}}
+	transaction = newTransfer().Sign(key).SignWithSigner(failing)
+	_, err = transaction.ToBytes()
+	require.ErrorIs(t, err, failing.err)
+	for i := range nodeAccountIDs {
+		require.Empty(t, transaction.signedTransactions._Get(i).(*services.SignedTransaction).GetSigMap().GetSigPair())
+	}
+}
+
+func TestUnitTransactionClientDefaultValidDuration(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	require.Equal(t, time.Duration(0), client.GetDefaultTransactionValidDuration())
+
+	client.SetDefaultTransactionValidDuration(150 * time.Second)
+	require.Equal(t, 150*time.Second, client.GetDefaultTransactionValidDuration())
+
+	transaction := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1))
+	require.Equal(t, 120*time.Second, transaction.GetTransactionValidDuration())
+
+	_, err = transaction.FreezeWith(client)
+	require.NoError(t, err)
+	require.Equal(t, 150*time.Second, transaction.GetTransactionValidDuration())
+
+	body := services.TransactionBody{}
+	require.NoError(t, protobuf.Unmarshal(transaction.signedTransactions._Get(0).(*services.SignedTransaction).BodyBytes, &body))
+	require.Equal(t, int64(150), body.GetTransactionValidDuration().GetSeconds())
+
+	transaction, err = NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionValidDuration(60 * time.Second).
+		FreezeWith(client)
+	require.NoError(t, err)
+	require.Equal(t, 60*time.Second, transaction.GetTransactionValidDuration())
+
+	client.SetDefaultTransactionValidDuration(10 * time.Minute)
+	require.Equal(t, 180*time.Second, client.GetDefaultTransactionValidDuration())
+}
+
+func TestUnitTransactionMaxMemoBytes(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	require.Equal(t, 100, client.GetMaxTransactionMemoBytes())
+
+	memo := strings.Repeat("m", 150)
+	freeze := func() error {
+		_, err := NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetTransactionMemo(memo).
+			AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+			FreezeWith(client)
+		return err
+	}
+
+	err = freeze()
+	require.ErrorIs(t, err, ErrMemoTooLong{Length: 150, MaxLength: 100})
+
+	client.SetMaxTransactionMemoBytes(200)
+	require.Equal(t, 200, client.GetMaxTransactionMemoBytes())
+	require.NoError(t, freeze())
+}
+
+type _MockKMSSigner struct {
+	key   PrivateKey
+	err   error
+	calls int32
+}
+
+func (signer *_MockKMSSigner) PublicKey() PublicKey {
+	return signer.key.PublicKey()
+}
+
+func (signer *_MockKMSSigner) Sign(message []byte) ([]byte, error) {
+	atomic.AddInt32(&signer.calls, 1)
+	if signer.err != nil {
+		return nil, signer.err
+	}
+
+	return signer.key.Sign(message), nil
+}
+
+func TestUnitTransactionSignWithSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	kms := &_MockKMSSigner{key: key}
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, kms)
+	require.Equal(t, key.PublicKey().String(), client.GetOperatorPublicKey().String())
+
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	_, err = transaction.SignWithOperator(client)
+	require.NoError(t, err)
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&kms.calls))
+
+	signatures, err := transaction.GetSignatures()
+	require.NoError(t, err)
+	for i, nodeAccountID := range []AccountID{{Account: 3}, {Account: 4}} {
+		require.Len(t, signatures[nodeAccountID], 1)
+		for publicKey, signature := range signatures[nodeAccountID] {
+			require.Equal(t, key.PublicKey().String(), publicKey.String())
+			require.True(t, key.PublicKey().Verify(transaction.GetSignedTransactionBodyBytes(i), signature))
+		}
+	}
+
+	failing := &_MockKMSSigner{key: key, err: fmt.Errorf("kms unavailable")}
+	transaction, err = NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	_, err = transaction.SignWithSigner(failing).ToBytes()
+	var signerErr ErrSignerFailed
+	require.ErrorAs(t, err, &signerErr)
+	require.EqualError(t, signerErr.Err, "kms unavailable")
+}
+
+func TestUnitTransactionFailingSignerSendsNoRequest(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	var requests int32
+	transactionCall := func(request *services.Transaction) *services.TransactionResponse {
+		atomic.AddInt32(&requests, 1)
+		return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK}
+	}
+	queryCall := func(request *services.Query) *services.Response {
+		atomic.AddInt32(&requests, 1)
+		return &services.Response{}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{transactionCall, transactionCall, queryCall, queryCall}})
+	defer server.Close()
+
+	failing := &_MockKMSSigner{key: key, err: fmt.Errorf("kms unavailable")}
+	_, err = NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		SignWithSigner(failing).
+		Execute(client)
+	var signerErr ErrSignerFailed
+	require.ErrorAs(t, err, &signerErr)
+	require.Equal(t, int32(1), atomic.LoadInt32(&failing.calls))
+
+	client.SetOperatorWithSigner(client.GetOperatorAccountID(), failing)
+	_, err = NewAccountInfoQuery().
+		SetAccountID(AccountID{Account: 1234}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetQueryPayment(HbarFromTinybar(1)).
+		Execute(client)
+	require.ErrorAs(t, err, &signerErr)
+
+	require.Zero(t, atomic.LoadInt32(&requests))
+}
+
+func TestUnitTransactionSignWithNodeBodySignerRejects(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	errNotAllowed := fmt.Errorf("node is not on the allowlist")
+	allowlist := map[AccountID]bool{{Account: 3}: true, {Account: 5}: true}
+	signer := func(nodeAccountID AccountID, bodyBytes []byte) ([]byte, error) {
+		if !allowlist[nodeAccountID] {
+			return nil, errNotAllowed
+		}
+		return key.Sign(bodyBytes), nil
+	}
+
+	newTransfer := func(nodeAccountIDs []AccountID) *TransferTransaction {
+		transaction, err := NewTransferTransaction().
+			SetNodeAccountIDs(nodeAccountIDs).
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+			AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+			Freeze()
+		require.NoError(t, err)
+		return transaction
+	}
+
+	transaction := newTransfer([]AccountID{{Account: 3}, {Account: 5}}).
+		SignWithSigner(NewNodeBodySigner(key.PublicKey(), signer))
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+	signatures, err := transaction.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 5}], 1)
+
+	_, err = newTransfer([]AccountID{{Account: 3}, {Account: 4}, {Account: 5}}).
+		SignWithSigner(NewNodeBodySigner(key.PublicKey(), signer)).
+		ToBytes()
+	var signerErr ErrSignerFailed
+	require.ErrorAs(t, err, &signerErr)
+	require.Equal(t, key.PublicKey().String(), signerErr.PublicKey.String())
+	require.ErrorIs(t, err, errNotAllowed)
+}
+
+func TestUnitTransferTransactionSignWithContext(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	newTransfer := func() *TransferTransaction {
+		transaction, err := NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}, {Account: 5}}).
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+			AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+			Freeze()
+		require.NoError(t, err)
+		return transaction
+	}
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "hsm-session")
+	transaction := newTransfer()
+	err = transaction.SignWithContext(ctx, key.PublicKey(), func(ctx context.Context, message []byte) ([]byte, error) {
+		require.Equal(t, "hsm-session", ctx.Value(ctxKey{}))
+		return key.Sign(message), nil
+	})
+	require.NoError(t, err)
+	signatures, err := transaction.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 4}], 1)
+
+	// The context is only used while signing, so canceling it afterwards doesn't affect the build.
+	canceled, cancel := context.WithCancel(context.Background())
+	transaction = newTransfer()
+	err = transaction.SignWithContext(canceled, key.PublicKey(), func(ctx context.Context, message []byte) ([]byte, error) {
+		return key.Sign(message), nil
+	})
+	require.NoError(t, err)
+	cancel()
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+
+	errHsmDown := fmt.Errorf("hsm unavailable")
+	calls := 0
+	transaction = newTransfer()
+	err = transaction.SignWithContext(context.Background(), key.PublicKey(), func(ctx context.Context, message []byte) ([]byte, error) {
+		calls++
+		if calls == 2 {
+			return nil, errHsmDown
+		}
+		return key.Sign(message), nil
+	})
+	require.ErrorIs(t, err, errHsmDown)
+	signatures, err = transaction.GetSignatures()
+	require.NoError(t, err)
+	require.Empty(t, signatures[AccountID{Account: 3}])
+
+	err = transaction.SignWithContext(canceled, key.PublicKey(), func(ctx context.Context, message []byte) ([]byte, error) {
+		t.Fatal("signer called with a canceled context")
+		return nil, nil
+	})
+	var signerErr ErrSignerFailed
+	require.ErrorAs(t, err, &signerErr)
+	require.ErrorIs(t, err, context.Canceled)
+
+	err = NewTransferTransaction().SignWithContext(ctx, key.PublicKey(), func(ctx context.Context, message []byte) ([]byte, error) {
+		return key.Sign(message), nil
+	})
+	require.ErrorIs(t, err, ErrTransactionIsNotFrozen)
+}
+
+func TestUnitTransactionAutoSignWithOperatorDisabled(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	call := func(request *services.Transaction) *services.TransactionResponse {
+		signedTransaction := services.SignedTransaction{}
+		require.NoError(t, protobuf.Unmarshal(request.SignedTransactionBytes, &signedTransaction))
+
+		sigPairs := signedTransaction.GetSigMap().GetSigPair()
+		require.Len(t, sigPairs, 1)
+		require.Equal(t, key.PublicKey().BytesRaw(), sigPairs[0].PubKeyPrefix)
+
+		return &services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call}})
+	defer server.Close()
+
+	require.True(t, client.GetAutoSignWithOperator())
+	client.SetAutoSignWithOperator(false)
+	require.False(t, client.GetAutoSignWithOperator())
+
+	newTransaction := func() *TransferTransaction {
+		return NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetTransactionID(TransactionIDGenerate(client.GetOperatorAccountID())).
+			AddHbarTransfer(client.GetOperatorAccountID(), NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, NewHbar(1))
+	}
+
+	_, err = newTransaction().Execute(client)
+	require.ErrorIs(t, err, ErrNoSignatures)
+
+	transaction, err := newTransaction().FreezeWith(client)
+	require.NoError(t, err)
+	_, err = transaction.Sign(key).Execute(client)
+	require.NoError(t, err)
+}
+
+func TestUnitTransactionSignatureMapOrderIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]PrivateKey, 3)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+
+	transactionID := TransactionIDGenerate(AccountID{Account: 1800})
+	signedBytes := func(order []int) []byte {
+		transaction, err := NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+			SetTransactionID(transactionID).
+			AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+			Freeze()
+		require.NoError(t, err)
+
+		for _, i := range order {
+			transaction.Sign(keys[i])
+		}
+
+		data, err := transaction.ToBytes()
+		require.NoError(t, err)
+		return data
+	}
+
+	expected := signedBytes([]int{0, 1, 2})
+	require.Equal(t, expected, signedBytes([]int{2, 0, 1}))
+	require.Equal(t, expected, signedBytes([]int{1, 2, 0}))
+
+	list := sdk.TransactionList{}
+	require.NoError(t, protobuf.Unmarshal(expected, &list))
+	for _, transaction := range list.TransactionList {
+		signed := services.SignedTransaction{}
+		require.NoError(t, protobuf.Unmarshal(transaction.SignedTransactionBytes, &signed))
+
+		sigPairs := signed.SigMap.SigPair
+		require.Len(t, sigPairs, 3)
+		for i := 1; i < len(sigPairs); i++ {
+			require.Negative(t, bytes.Compare(sigPairs[i-1].PubKeyPrefix, sigPairs[i].PubKeyPrefix))
+		}
+	}
+}
+
+func TestUnitTransactionUseHealthyNodesOnly(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{
+		"nonexistent-testnet-1:50211": {Account: 3},
+		"nonexistent-testnet-2:50211": {Account: 4},
+		"nonexistent-testnet-3:50211": {Account: 5},
+	})
+	client.SetNodeMinBackoff(time.Minute)
+	client.SetNodeMaxBackoff(time.Hour)
+	client.SetMaxNodesPerTransaction(3)
+	require.False(t, client.GetUseHealthyNodesOnly())
+
+	newTransfer := func() *TransferTransaction {
+		return NewTransferTransaction().
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+			AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 1234}, HbarFromTinybar(1))
+	}
+
+	unhealthy, ok := client.network._GetNodeForAccountID(AccountID{Account: 4})
+	require.True(t, ok)
+	client.network._IncreaseBackoff(unhealthy)
+	require.False(t, unhealthy._IsHealthy())
+
+	// With fewer healthy nodes than nodes per transaction, the backed off node is used last.
+	transfer, err := newTransfer().FreezeWith(client)
+	require.NoError(t, err)
+	nodeAccountIDs := transfer.GetNodeAccountIDs()
+	require.ElementsMatch(t, []AccountID{{Account: 3}, {Account: 4}, {Account: 5}}, nodeAccountIDs)
+	require.Equal(t, AccountID{Account: 4}, nodeAccountIDs[2])
+
+	client.SetUseHealthyNodesOnly(true)
+	require.True(t, client.GetUseHealthyNodesOnly())
+
+	transfer, err = newTransfer().FreezeWith(client)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []AccountID{{Account: 3}, {Account: 5}}, transfer.GetNodeAccountIDs())
+
+	for _, accountID := range []AccountID{{Account: 3}, {Account: 5}} {
+		node, ok := client.network._GetNodeForAccountID(accountID)
+		require.True(t, ok)
+		client.network._IncreaseBackoff(node)
+	}
+
+	transfer, err = newTransfer().FreezeWith(client)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []AccountID{{Account: 3}, {Account: 4}, {Account: 5}}, transfer.GetNodeAccountIDs())
+}
+
+func TestUnitTransactionClientValidStartSkew(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	require.Nil(t, client.GetValidStartSkew())
+
+	skew := 3 * time.Second
+	client.SetValidStartSkew(skew)
+	require.Equal(t, skew, *client.GetValidStartSkew())
+
+	before := time.Now()
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	after := time.Now()
+
+	validStart := *transaction.GetTransactionID().ValidStart
+	require.False(t, validStart.Before(before.Add(-skew)))
+	require.False(t, validStart.After(after.Add(-skew)))
+
+	client.SetValidStartSkew(-time.Second)
+	require.Equal(t, time.Duration(0), *client.GetValidStartSkew())
+}
+
+func TestUnitTransactionNetworkDefaultMaxTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	require.Equal(t, NewHbar(2), client.GetDefaultMaxTransactionFeeForNetwork(NetworkNameMainnet))
+	require.Equal(t, NewHbar(1), client.GetDefaultMaxTransactionFeeForNetwork(NetworkNamePreviewnet))
+
+	freeze := func() *TransferTransaction {
+		transaction, err := NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+			FreezeWith(client)
+		require.NoError(t, err)
+		return transaction
+	}
+
+	client.SetLedgerID(*NewLedgerIDMainnet())
+	require.Equal(t, NewHbar(2), freeze().GetMaxTransactionFee())
+
+	client.SetLedgerID(*NewLedgerIDPreviewnet())
+	require.Equal(t, NewHbar(1), freeze().GetMaxTransactionFee())
+
+	require.NoError(t, client.SetDefaultMaxTransactionFeeForNetwork(NetworkNamePreviewnet, NewHbar(3)))
+	require.Equal(t, NewHbar(3), freeze().GetMaxTransactionFee())
+
+	// A type whose own default is higher keeps it
+	accountCreate, err := NewAccountCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		FreezeWith(client)
+	require.NoError(t, err)
+	require.Equal(t, NewHbar(5), accountCreate.GetMaxTransactionFee())
+
+	require.NoError(t, client.SetDefaultMaxTransactionFee(NewHbar(4)))
+	require.Equal(t, NewHbar(4), freeze().GetMaxTransactionFee())
+
+	require.Error(t, client.SetDefaultMaxTransactionFeeForNetwork(NetworkNameMainnet, NewHbar(-1)))
+}
+
+func TestUnitTransactionLearnsRequiredFee(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_INSUFFICIENT_TX_FEE,
+			Cost:                        250_000_000,
+		},
+	}}
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	_, ok := client.GetEstimatedMaxFee("TransferTransaction")
+	require.False(t, ok)
+
+	newTransaction := func() *TransferTransaction {
+		return NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, NewHbar(1))
+	}
+
+	_, err := newTransaction().Execute(client)
+	require.ErrorIs(t, err, ErrHederaPreCheckStatus{Status: StatusInsufficientTxFee})
+
+	fee, ok := client.GetEstimatedMaxFee("TransferTransaction")
+	require.True(t, ok)
+	require.Equal(t, HbarFromTinybar(250_000_000), fee)
+
+	// The second estimate comes from the cache, without contacting a node
+	transaction, err := newTransaction().FreezeWith(client)
+	require.NoError(t, err)
+	require.Equal(t, HbarFromTinybar(250_000_000), transaction.GetMaxTransactionFee())
+
+	_, ok = client.GetEstimatedMaxFee("AccountCreateTransaction")
+	require.False(t, ok)
+
+	client.InvalidateFeeCache()
+	_, ok = client.GetEstimatedMaxFee("TransferTransaction")
+	require.False(t, ok)
+}
+
+func TestUnitTransactionCheckSignaturesThresholdKey(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]PrivateKey, 3)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	accountKey := KeyListWithThreshold(2).
+		AddAllPublicKeys([]PublicKey{keys[0].PublicKey(), keys[1].PublicKey(), keys[2].PublicKey()})
+
+	// The account key as AccountInfoQuery returns it
+	key, err := _KeyFromProtobuf(accountKey._ToProtoKey())
+	require.NoError(t, err)
+
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1234})).
+		AddHbarTransfer(AccountID{Account: 1234}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	transaction.Sign(keys[0])
+
+	err = transaction.CheckSignatures(key)
+	var missing ErrSignaturesMissing
+	require.ErrorAs(t, err, &missing)
+	require.Equal(t, 1, missing.Remaining)
+	require.Equal(t, []PublicKey{keys[1].PublicKey(), keys[2].PublicKey()}, missing.MissingKeys)
+	require.Contains(t, err.Error(), keys[1].PublicKey().String())
+
+	transaction.Sign(keys[2])
+	require.NoError(t, transaction.CheckSignatures(key))
+}
+
+func TestUnitTransactionBase64RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1234})).
+		AddHbarTransfer(AccountID{Account: 1234}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+	transaction.Sign(key)
+
+	encoded, err := transaction.ToBase64()
+	require.NoError(t, err)
+
+	decoded, err := TransactionFromBase64(encoded)
+	require.NoError(t, err)
+	result, ok := decoded.(TransferTransaction)
+	require.True(t, ok)
+
+	require.Equal(t, transaction.GetTransactionID().String(), result.GetTransactionID().String())
+	require.Equal(t, transaction.GetHbarTransfers(), result.GetHbarTransfers())
+
+	expected, err := transaction.GetSignatures()
+	require.NoError(t, err)
+	signatures, err := result.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures, len(expected))
+	for nodeAccountID, sigs := range signatures {
+		require.Len(t, sigs, 1)
+		for publicKey, sig := range sigs {
+			require.Equal(t, key.PublicKey().String(), publicKey.String())
+			for _, expectedSig := range expected[nodeAccountID] {
+				require.Equal(t, expectedSig, sig)
+			}
+		}
+	}
+
+	_, err = TransactionFromBase64("not base64!")
+	require.Error(t, err)
+
+	_, err = NewTransferTransaction().ToBase64()
+	require.ErrorIs(t, err, ErrTransactionIsNotFrozen)
+}
+
+func TestUnitTransactionDuplicateTransactionIDCheck(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+	}}
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	require.Equal(t, DuplicateTransactionIDCheckOff, client.GetDuplicateTransactionIDCheck())
+	client.SetDuplicateTransactionIDCheck(DuplicateTransactionIDCheckError)
+
+	transactionID := TransactionIDGenerate(client.GetOperatorAccountID())
+	newTransfer := func() *TransferTransaction {
+		return NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetTransactionID(transactionID).
+			AddHbarTransfer(client.GetOperatorAccountID(), HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 1234}, HbarFromTinybar(1))
+	}
+
+	_, err := newTransfer().Execute(client)
+	require.NoError(t, err)
+
+	_, err = newTransfer().Execute(client)
+	var duplicate ErrDuplicateTransactionID
+	require.ErrorAs(t, err, &duplicate)
+	require.Equal(t, transactionID.String(), duplicate.TransactionID.String())
+
+	expired := NewTransactionIDWithValidStart(AccountID{Account: 5}, time.Now().Add(-time.Hour))
+	require.NoError(t, client._CheckDuplicateTransactionID(expired, 2*time.Minute, client.logger))
+	require.NoError(t, client._CheckDuplicateTransactionID(expired, 2*time.Minute, client.logger))
+}
+
+func TestUnitTransactionSigningProgress(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]PrivateKey, 3)
+	publicKeys := make([]PublicKey, 3)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key
+		publicKeys[i] = key.PublicKey()
+	}
+
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1234})).
+		AddHbarTransfer(AccountID{Account: 1234}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		SetSigningKeys(publicKeys).
+		Freeze()
+	require.NoError(t, err)
+	require.Equal(t, publicKeys, transaction.GetSigningKeys())
+
+	unsigned, err := transaction.ToBytes()
+	require.NoError(t, err)
+
+	progress := transaction.GetSigningProgress()
+	require.Empty(t, progress.Signed)
+	require.Equal(t, "0 of 3 expected keys have signed", progress.String())
+
+	transaction.Sign(keys[0])
+	transaction.SignWith(publicKeys[2], keys[2].Sign)
+
+	progress = transaction.GetSigningProgress()
+	require.Equal(t, []PublicKey{publicKeys[0], publicKeys[2]}, progress.Signed)
+	require.Equal(t, []PublicKey{publicKeys[1]}, progress.Missing)
+	require.Equal(t, "2 of 3 expected keys have signed", progress.String())
+
+	unsignedWithoutKeys, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(transaction.GetTransactionID()).
+		AddHbarTransfer(AccountID{Account: 1234}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+	expected, err := unsignedWithoutKeys.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, expected, unsigned)
+}
+
+func TestUnitTransactionFreezeOffline(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transactionID := TransactionIDGenerate(AccountID{Account: 1800})
+	nodeAccountIDs := []AccountID{{Account: 3}, {Account: 4}}
+
+	tx, err := NewTransferTransaction().
+		SetTransactionID(transactionID).
+		SetNodeAccountIDs(nodeAccountIDs).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+	tx.Sign(key)
+
+	data, err := tx.ToBytes()
+	require.NoError(t, err)
+
+	decoded, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+	transfer, ok := decoded.(TransferTransaction)
+	require.True(t, ok)
+	require.Equal(t, transactionID.String(), transfer.GetTransactionID().String())
+	require.Equal(t, nodeAccountIDs, transfer.GetNodeAccountIDs())
+	require.Equal(t, defaultTransactionValidDuration, transfer.GetTransactionValidDuration())
+
+	_, err = NewTransferTransaction().
+		SetNodeAccountIDs(nodeAccountIDs).
+		Freeze()
+	require.ErrorIs(t, err, ErrNoClientOrTransactionID)
+
+	_, err = NewTransferTransaction().
+		SetTransactionID(transactionID).
+		Freeze()
+	require.ErrorIs(t, err, ErrNoClientOrTransactionIDOrNodeID)
+}
+
+func TestUnitTransactionMergeSignatures(t *testing.T) {
+	t.Parallel()
+
+	nodeAccountIDs := []AccountID{{Account: 3}, {Account: 4}}
+	newTransfer := func(amount int64) *TransferTransaction {
+		tx, err := NewTransferTransaction().
+			SetTransactionID(NewTransactionIDWithValidStart(AccountID{Account: 1800}, time.Unix(1694689200, 0))).
+			SetNodeAccountIDs(nodeAccountIDs).
+			AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-amount)).
+			AddHbarTransfer(AccountID{Account: 98}, HbarFromTinybar(amount)).
+			Freeze()
+		require.NoError(t, err)
+		return tx
+	}
+
+	coordinator := newTransfer(1)
+	unsigned, err := coordinator.ToBytes()
+	require.NoError(t, err)
+
+	keys := make([]PublicKey, 0)
+	copies := make([][]byte, 0)
+	for i := 0; i < 2; i++ {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys = append(keys, key.PublicKey())
+
+		decoded, err := TransactionFromBytes(unsigned)
+		require.NoError(t, err)
+		transfer := decoded.(TransferTransaction)
+		signed, err := transfer.Sign(key).ToBytes()
+		require.NoError(t, err)
+		copies = append(copies, signed)
+	}
+
+	for _, signed := range append(copies, copies[0]) {
+		require.NoError(t, coordinator.MergeSignatures(signed))
+	}
+	require.NoError(t, coordinator.CheckSignatures(KeyListWithThreshold(2).AddAllPublicKeys(keys)))
+
+	merged, err := coordinator.ToBytes()
+	require.NoError(t, err)
+	decoded, err := TransactionFromBytes(merged)
+	require.NoError(t, err)
+	transfer := decoded.(TransferTransaction)
+	signatures, err := transfer.GetSignatures()
+	require.NoError(t, err)
+	for _, nodeAccountID := range nodeAccountIDs {
+		require.Len(t, signatures[nodeAccountID], 2)
+	}
+
+	other, err := newTransfer(2).ToBytes()
+	require.NoError(t, err)
+	require.ErrorIs(t, coordinator.MergeSignatures(other), ErrSignatureMergeBodyMismatch)
+	require.ErrorIs(t, NewTransferTransaction().MergeSignatures(merged), ErrTransactionIsNotFrozen)
+
+	// A valid signature on the first body and an unparsable key on the second must not be merged at all.
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	list := sdk.TransactionList{}
+	require.NoError(t, protobuf.Unmarshal(unsigned, &list))
+	for i, transaction := range list.TransactionList {
+		signed := services.SignedTransaction{}
+		require.NoError(t, protobuf.Unmarshal(transaction.GetSignedTransactionBytes(), &signed))
+		sigPair := key.PublicKey()._ToSignaturePairProtobuf(key.Sign(signed.GetBodyBytes()))
+		if i == 1 {
+			sigPair.PubKeyPrefix = []byte{1, 2, 3}
+		}
+		signed.SigMap = &services.SignatureMap{SigPair: []*services.SignaturePair{sigPair}}
+		transaction.SignedTransactionBytes, err = protobuf.Marshal(&signed)
+		require.NoError(t, err)
+	}
+	corrupt, err := protobuf.Marshal(&list)
+	require.NoError(t, err)
+	require.Error(t, coordinator.MergeSignatures(corrupt))
+
+	unchanged, err := coordinator.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, merged, unchanged)
+}
+
+func TestUnitTransactionVerifyBodiesConsistent(t *testing.T) {
+	t.Parallel()
+
+	nodeAccountIDs := []AccountID{{Account: 3}, {Account: 4}, {Account: 5}}
+	transfer, err := NewTransferTransaction().
+		SetTransactionID(NewTransactionIDWithValidStart(AccountID{Account: 1800}, time.Unix(1694689200, 0))).
+		SetNodeAccountIDs(nodeAccountIDs).
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, HbarFromTinybar(1)).
+		Freeze()
+	require.NoError(t, err)
+	require.NoError(t, transfer.VerifyBodiesConsistent())
+
+	signed := transfer.signedTransactions._Get(2).(*services.SignedTransaction)
+	body := services.TransactionBody{}
+	require.NoError(t, protobuf.Unmarshal(signed.BodyBytes, &body))
+	body.Memo = "tampered"
+	signed.BodyBytes, err = protobuf.Marshal(&body)
+	require.NoError(t, err)
+
+	err = transfer.VerifyBodiesConsistent()
+	require.Equal(t, ErrTransactionBodiesInconsistent{NodeAccountID: nodeAccountIDs[2], FirstNodeAccountID: nodeAccountIDs[0]}, err)
+
+	require.ErrorIs(t, NewTransferTransaction().VerifyBodiesConsistent(), ErrTransactionIsNotFrozen)
+}
+
+func TestUnitTransactionGetSignedPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	transfer, err := NewTransferTransaction().
+		SetTransactionID(NewTransactionIDWithValidStart(AccountID{Account: 1800}, time.Unix(1694689200, 0))).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, HbarFromTinybar(1)).
+		Freeze()
+	require.NoError(t, err)
+	require.Empty(t, transfer.GetSignedPublicKeys())
+
+	first, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	second, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transfer.Sign(first)
+	transfer.AddSignature(second.PublicKey(), second.Sign(transfer.GetSignedTransactionBodyBytes(0)))
+
+	signed := transfer.GetSignedPublicKeys()
+	require.Equal(t, []PublicKey{first.PublicKey(), second.PublicKey()}, signed)
+	signed[0] = second.PublicKey()
+	require.Equal(t, first.PublicKey(), transfer.GetSignedPublicKeys()[0])
+
+	data, err := transfer.ToBytes()
+	require.NoError(t, err)
+	decoded, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+	roundTrip := decoded.(TransferTransaction)
+	require.ElementsMatch(t, []PublicKey{first.PublicKey(), second.PublicKey()}, roundTrip.GetSignedPublicKeys())
+}
@@ -0,0 +1,120 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// TransferTemplate describes a recurring hbar transfer whose accounts and amounts are named placeholders.
+// The placeholders are filled in with SetAccount and SetAmount, and every Build produces a fresh, unfrozen
+// TransferTransaction, so one template can be reused without mutating and re-freezing a shared builder.
+type TransferTemplate struct {
+	legs     []_TransferTemplateLeg
+	accounts map[string]AccountID
+	amounts  map[string]Hbar
+	memo     string
+}
+
+type _TransferTemplateLeg struct {
+	accountKey string
+	amountKey  string
+	debit      bool
+}
+
+// NewTransferTemplate creates an empty TransferTemplate.
+func NewTransferTemplate() *TransferTemplate {
+	return &TransferTemplate{
+		legs:     make([]_TransferTemplateLeg, 0),
+		accounts: make(map[string]AccountID),
+		amounts:  make(map[string]Hbar),
+	}
+}
+
+// AddHbarDebit adds a leg taking the amount named amountKey from the account named accountKey.
+func (template *TransferTemplate) AddHbarDebit(accountKey string, amountKey string) *TransferTemplate {
+	template.legs = append(template.legs, _TransferTemplateLeg{accountKey: accountKey, amountKey: amountKey, debit: true})
+	return template
+}
+
+// AddHbarCredit adds a leg giving the amount named amountKey to the account named accountKey.
+func (template *TransferTemplate) AddHbarCredit(accountKey string, amountKey string) *TransferTemplate {
+	template.legs = append(template.legs, _TransferTemplateLeg{accountKey: accountKey, amountKey: amountKey})
+	return template
+}
+
+// SetAccount fills in the account placeholder named key.
+func (template *TransferTemplate) SetAccount(key string, accountID AccountID) *TransferTemplate {
+	template.accounts[key] = accountID
+	return template
+}
+
+// GetAccount returns the account filled in for the placeholder named key, if any.
+func (template *TransferTemplate) GetAccount(key string) (AccountID, bool) {
+	accountID, ok := template.accounts[key]
+	return accountID, ok
+}
+
+// SetAmount fills in the amount placeholder named key.
+func (template *TransferTemplate) SetAmount(key string, amount Hbar) *TransferTemplate {
+	template.amounts[key] = amount
+	return template
+}
+
+// GetAmount returns the amount filled in for the placeholder named key, if any.
+func (template *TransferTemplate) GetAmount(key string) (Hbar, bool) {
+	amount, ok := template.amounts[key]
+	return amount, ok
+}
+
+// SetTransactionMemo sets the memo of every transaction built from the template.
+func (template *TransferTemplate) SetTransactionMemo(memo string) *TransferTemplate {
+	template.memo = memo
+	return template
+}
+
+// GetTransactionMemo returns the memo of every transaction built from the template.
+func (template *TransferTemplate) GetTransactionMemo() string {
+	return template.memo
+}
+
+// Build returns a new, unfrozen TransferTransaction with the template's placeholders filled in with their
+// current values. It fails if any placeholder used by a leg has not been set.
+func (template *TransferTemplate) Build() (*TransferTransaction, error) {
+	tx := NewTransferTransaction().
+		SetTransactionMemo(template.memo)
+
+	for _, leg := range template.legs {
+		accountID, ok := template.accounts[leg.accountKey]
+		if !ok {
+			return nil, ErrTemplatePlaceholderUnset{Placeholder: leg.accountKey}
+		}
+
+		amount, ok := template.amounts[leg.amountKey]
+		if !ok {
+			return nil, ErrTemplatePlaceholderUnset{Placeholder: leg.amountKey}
+		}
+
+		if leg.debit {
+			amount = amount.Negated()
+		}
+
+		tx.AddHbarTransfer(accountID, amount)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,65 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2024 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTransferTemplateBuild(t *testing.T) {
+	t.Parallel()
+
+	template := NewTransferTemplate().
+		AddHbarDebit("payer", "amount").
+		AddHbarCredit("payee", "amount").
+		SetAccount("payer", AccountID{Account: 1800}).
+		SetAccount("payee", AccountID{Account: 5}).
+		SetTransactionMemo("rent")
+
+	_, err := template.Build()
+	require.ErrorIs(t, err, ErrTemplatePlaceholderUnset{Placeholder: "amount"})
+
+	first, err := template.SetAmount("amount", NewHbar(5)).Build()
+	require.NoError(t, err)
+	second, err := template.SetAmount("amount", NewHbar(7)).Build()
+	require.NoError(t, err)
+
+	require.False(t, first.IsFrozen())
+	require.False(t, second.IsFrozen())
+	require.Equal(t, "rent", second.GetTransactionMemo())
+
+	require.Equal(t, map[AccountID]Hbar{
+		{Account: 1800}: NewHbar(-5),
+		{Account: 5}:    NewHbar(5),
+	}, first.GetHbarTransfers())
+	require.Equal(t, map[AccountID]Hbar{
+		{Account: 1800}: NewHbar(-7),
+		{Account: 5}:    NewHbar(7),
+	}, second.GetHbarTransfers())
+
+	_, err = NewTransferTemplate().AddHbarCredit("payee", "amount").SetAmount("amount", NewHbar(1)).Build()
+	require.ErrorIs(t, err, ErrTemplatePlaceholderUnset{Placeholder: "payee"})
+}
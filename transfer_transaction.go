@@ -21,12 +21,24 @@ package hedera
  */
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// The most hbar and token transfers the network accepts in one TransferTransaction
+const (
+	maxHbarTransfers  = 10
+	maxTokenTransfers = 10
 )
 
 // TransferTransaction
@@ -41,9 +53,11 @@ import (
 // are in the same order as the accounts, skipping those accounts that don't need a signature.
 type TransferTransaction struct {
 	Transaction
-	tokenTransfers map[TokenID]*_TokenTransfer
-	hbarTransfers  []*_HbarTransfer
-	nftTransfers   map[TokenID][]*TokenNftTransfer
+	tokenTransfers      map[TokenID]*_TokenTransfer
+	hbarTransfers       []*_HbarTransfer
+	nftTransfers        map[TokenID][]*TokenNftTransfer
+	verifyTokenDecimals bool
+	validateTransfers   bool
 }
 
 // NewTransferTransaction creates TransferTransaction which
@@ -69,6 +83,77 @@ func NewTransferTransaction() *TransferTransaction {
 	return &tx
 }
 
+// TransferTransactionFromSchedulableBodyBytes rebuilds the TransferTransaction held by a serialized
+// SchedulableTransactionBody, e.g. the scheduled body of a schedule. The result is frozen and only meant for
+// reading its transfers with GetHbarTransfers, GetTokenTransfers and GetNftTransfers.
+// It returns ErrSchedulableBodyNotTransfer if the body holds a different transaction type.
+func TransferTransactionFromSchedulableBodyBytes(data []byte) (*TransferTransaction, error) {
+	if data == nil {
+		return &TransferTransaction{}, ErrByteArrayNull
+	}
+
+	pb := services.SchedulableTransactionBody{}
+	if err := protobuf.Unmarshal(data, &pb); err != nil {
+		return &TransferTransaction{}, ErrSerialization{Message: "error deserializing SchedulableTransactionBody", Err: err}
+	}
+
+	if pb.GetCryptoTransfer() == nil {
+		return &TransferTransaction{}, ErrSchedulableBodyNotTransfer
+	}
+
+	body := &services.TransactionBody{
+		TransactionFee: pb.GetTransactionFee(),
+		Memo:           pb.GetMemo(),
+		Data: &services.TransactionBody_CryptoTransfer{
+			CryptoTransfer: pb.GetCryptoTransfer(),
+		},
+	}
+
+	bodyBytes, err := protobuf.Marshal(body)
+	if err != nil {
+		return &TransferTransaction{}, ErrSerialization{Message: "error serializing TransactionBody", Err: err}
+	}
+
+	tx := _NewTransaction()
+	tx.memo = pb.GetMemo()
+	tx.transactionFee = pb.GetTransactionFee()
+	tx.signedTransactions._Push(&services.SignedTransaction{
+		BodyBytes: bodyBytes,
+		SigMap:    &services.SignatureMap{},
+	})
+
+	return _TransferTransactionFromProtobuf(tx, body), nil
+}
+
+// NewSweepHbarTransaction builds a TransferTransaction which moves the whole hbar balance of source to destination,
+// minus estimatedFee. The source account's balance is queried with the client. The source account pays for
+// the transaction: its transaction ID is generated for source and its max transaction fee is set to
+// estimatedFee, so whatever part of the estimate is not charged stays in the source account.
+// It returns ErrInsufficientBalanceForFee if the balance does not exceed the estimated fee.
+func NewSweepHbarTransaction(client *Client, source AccountID, destination AccountID, estimatedFee Hbar) (*TransferTransaction, error) {
+	balance, err := NewAccountBalanceQuery().
+		SetAccountID(source).
+		Execute(client)
+	if err != nil {
+		return nil, err
+	}
+
+	if balance.Hbars.AsTinybar() <= estimatedFee.AsTinybar() {
+		return nil, ErrInsufficientBalanceForFee{
+			Balance: balance.Hbars,
+			Fee:     estimatedFee,
+		}
+	}
+
+	amount := HbarFromTinybar(balance.Hbars.AsTinybar() - estimatedFee.AsTinybar())
+
+	return NewTransferTransaction().
+		SetTransactionID(client._GenerateTransactionID(source)).
+		SetMaxTransactionFee(estimatedFee).
+		AddHbarTransfer(source, amount.Negated()).
+		AddHbarTransfer(destination, amount), nil
+}
+
 func _TransferTransactionFromProtobuf(tx Transaction, pb *services.TransactionBody) *TransferTransaction {
 	tokenTransfers := make(map[TokenID]*_TokenTransfer)
 	nftTransfers := make(map[TokenID][]*TokenNftTransfer)
@@ -179,15 +264,75 @@ func (tx *TransferTransaction) GetTokenTransfers() map[TokenID][]TokenTransfer {
 	return transfers
 }
 
-// GetHbarTransfers returns the hbar transfers
+// GetHbarTransfers returns the hbar transfers, summed per account. A transaction decoded from bytes may
+// list the same account more than once; those amounts are added together rather than overwritten.
 func (tx *TransferTransaction) GetHbarTransfers() map[AccountID]Hbar {
 	result := make(map[AccountID]Hbar)
 	for _, hbarTransfers := range tx.hbarTransfers {
-		result[*hbarTransfers.accountID] = hbarTransfers.Amount
+		accountID := *hbarTransfers.accountID
+		result[accountID] = HbarFromTinybar(result[accountID].AsTinybar() + hbarTransfers.Amount.AsTinybar())
 	}
 	return result
 }
 
+// String renders the transfers compactly for logging, e.g. "0.0.3:-5ℏ, 0.0.4:+5ℏ". Token transfers follow as
+// "0.0.5005[0.0.3:-7, 0.0.4:+7]" and NFT transfers as "0.0.6006#1[0.0.3->0.0.4]", separated by "; ".
+// Legs are sorted the same way as in the transaction body, so the same transfer always renders the same way.
+func (tx *TransferTransaction) String() string {
+	parts := make([]string, 0)
+
+	hbarTransfers := make([]*_HbarTransfer, len(tx.hbarTransfers))
+	copy(hbarTransfers, tx.hbarTransfers)
+	sort.Sort(&_HbarTransfers{hbarTransfers})
+	if len(hbarTransfers) > 0 {
+		legs := make([]string, 0, len(hbarTransfers))
+		for _, transfer := range hbarTransfers {
+			legs = append(legs, transfer.accountID.String()+":"+_FormatSignedHbar(transfer.Amount))
+		}
+		parts = append(parts, strings.Join(legs, ", "))
+	}
+
+	tokenTransfers := tx.GetTokenTransfers()
+	tokenIDs := make([]TokenID, 0, len(tokenTransfers))
+	for tokenID := range tokenTransfers {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Sort(_TokenIDs{tokenIDs: tokenIDs})
+	for _, tokenID := range tokenIDs {
+		legs := make([]string, 0, len(tokenTransfers[tokenID]))
+		for _, transfer := range tokenTransfers[tokenID] {
+			legs = append(legs, fmt.Sprintf("%s:%+d", transfer.AccountID.String(), transfer.Amount))
+		}
+		parts = append(parts, tokenID.String()+"["+strings.Join(legs, ", ")+"]")
+	}
+
+	tokenIDs = make([]TokenID, 0, len(tx.nftTransfers))
+	for tokenID := range tx.nftTransfers {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Sort(_TokenIDs{tokenIDs: tokenIDs})
+	for _, tokenID := range tokenIDs {
+		nftTransfers := make([]*TokenNftTransfer, len(tx.nftTransfers[tokenID]))
+		copy(nftTransfers, tx.nftTransfers[tokenID])
+		sort.Sort(&_TokenNftTransfers{nftTransfers})
+		for _, transfer := range nftTransfers {
+			parts = append(parts, fmt.Sprintf("%s#%d[%s->%s]", tokenID.String(), transfer.SerialNumber,
+				transfer.SenderAccountID.String(), transfer.ReceiverAccountID.String()))
+		}
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func _FormatSignedHbar(amount Hbar) string {
+	sign := "+"
+	if amount.AsTinybar() < 0 {
+		sign = "-"
+	}
+
+	return sign + strconv.FormatFloat(math.Abs(amount.As(HbarUnits.Hbar)), 'f', -1, 64) + HbarUnits.Hbar.Symbol()
+}
+
 // AddHbarTransfer Sets The desired hbar balance adjustments
 func (tx *TransferTransaction) AddHbarTransfer(accountID AccountID, amount Hbar) *TransferTransaction {
 	tx._RequireNotFrozen()
@@ -208,6 +353,18 @@ func (tx *TransferTransaction) AddHbarTransfer(accountID AccountID, amount Hbar)
 	return tx
 }
 
+// AddHbarTransferFromString adds an hbar transfer of a decimal hbar amount such as "1.25" or "-0.5", like
+// AddHbarTransfer. The amount is converted to tinybar exactly; it returns ErrInvalidHbarAmount instead of
+// rounding when the string is malformed, finer than one tinybar, or out of range.
+func (tx *TransferTransaction) AddHbarTransferFromString(accountID AccountID, amount string) (*TransferTransaction, error) {
+	hbar, err := _HbarFromDecimalString(amount)
+	if err != nil {
+		return tx, err
+	}
+
+	return tx.AddHbarTransfer(accountID, hbar), nil
+}
+
 // GetTokenIDDecimals returns the token decimals
 func (tx *TransferTransaction) GetTokenIDDecimals() map[TokenID]uint32 {
 	result := make(map[TokenID]uint32)
@@ -219,10 +376,93 @@ func (tx *TransferTransaction) GetTokenIDDecimals() map[TokenID]uint32 {
 	return result
 }
 
+// _CheckDecimals reports whether decimals agree with the expected decimals of the transfers already added for
+// tokenID. If they don't, an ErrTokenDecimalsMismatch is recorded and returned when the transaction is frozen.
+func (tx *TransferTransaction) _CheckDecimals(tokenID TokenID, decimals uint32) bool {
+	for token, tokenTransfer := range tx.tokenTransfers {
+		if token.Compare(tokenID) == 0 && tokenTransfer.ExpectedDecimals != nil && *tokenTransfer.ExpectedDecimals != decimals {
+			tx.freezeError = ErrTokenDecimalsMismatch{
+				TokenID:          tokenID,
+				ExpectedDecimals: *tokenTransfer.ExpectedDecimals,
+				Decimals:         decimals,
+			}
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// SetVerifyTokenDecimals sets if Execute calls VerifyTokenDecimals before submitting the transaction, failing
+// with ErrTokenDecimalsIncorrect instead of sending it if any decimals differ. Freezing never checks them, since
+// that would need network calls.
+func (tx *TransferTransaction) SetVerifyTokenDecimals(verify bool) *TransferTransaction {
+	tx._RequireNotFrozen()
+	tx.verifyTokenDecimals = verify
+	return tx
+}
+
+// GetVerifyTokenDecimals returns if Execute checks the expected token decimals before submitting the transaction.
+func (tx *TransferTransaction) GetVerifyTokenDecimals() bool {
+	return tx.verifyTokenDecimals
+}
+
+// SetValidateTransfers sets if freezing the transaction checks that the hbar transfers sum to zero, failing
+// with ErrHbarTransfersUnbalanced instead of sending a transaction the network rejects with
+// INVALID_ACCOUNT_AMOUNTS. It defaults to false.
+func (tx *TransferTransaction) SetValidateTransfers(validate bool) *TransferTransaction {
+	tx._RequireNotFrozen()
+	tx.validateTransfers = validate
+	return tx
+}
+
+// GetValidateTransfers returns if freezing the transaction checks that the hbar transfers sum to zero.
+func (tx *TransferTransaction) GetValidateTransfers() bool {
+	return tx.validateTransfers
+}
+
+// VerifyTokenDecimals checks the decimals passed to AddTokenTransferWithDecimals against each token's actual
+// decimals, running a TokenInfoQuery per token. It returns ErrTokenDecimalsIncorrect for the first mismatch.
+func (tx *TransferTransaction) VerifyTokenDecimals(client *Client) error {
+	tokenIDs := make([]TokenID, 0)
+	for tokenID, tokenTransfer := range tx.tokenTransfers {
+		if tokenTransfer.ExpectedDecimals != nil {
+			tokenIDs = append(tokenIDs, tokenID)
+		}
+	}
+	sort.Slice(tokenIDs, func(i, j int) bool {
+		return tokenIDs[i].Compare(tokenIDs[j]) < 0
+	})
+
+	infos := BatchTokenInfo(client, tokenIDs)
+	for _, tokenID := range tokenIDs {
+		result := infos[tokenID]
+		if result.Err != nil {
+			return result.Err
+		}
+
+		expected := *tx.tokenTransfers[tokenID].ExpectedDecimals
+		if result.Info.Decimals != expected {
+			return ErrTokenDecimalsIncorrect{
+				TokenID:          tokenID,
+				ExpectedDecimals: expected,
+				Decimals:         result.Info.Decimals,
+			}
+		}
+	}
+
+	return nil
+}
+
 // AddTokenTransferWithDecimals Sets the desired token unit balance adjustments with decimals
 func (tx *TransferTransaction) AddTokenTransferWithDecimals(tokenID TokenID, accountID AccountID, value int64, decimal uint32) *TransferTransaction { //nolint
 	tx._RequireNotFrozen()
 
+	if !tx._CheckDecimals(tokenID, decimal) {
+		return tx
+	}
+
 	for token, tokenTransfer := range tx.tokenTransfers {
 		if token.Compare(tokenID) == 0 {
 			for _, transfer := range tokenTransfer.Transfers {
@@ -344,6 +584,10 @@ func (tx *TransferTransaction) AddApprovedHbarTransfer(accountID AccountID, amou
 func (tx *TransferTransaction) AddApprovedTokenTransferWithDecimals(tokenID TokenID, accountID AccountID, value int64, decimal uint32, approve bool) *TransferTransaction { //nolint
 	tx._RequireNotFrozen()
 
+	if !tx._CheckDecimals(tokenID, decimal) {
+		return tx
+	}
+
 	for token, tokenTransfer := range tx.tokenTransfers {
 		if token.Compare(tokenID) == 0 {
 			for _, transfer := range tokenTransfer.Transfers {
@@ -451,6 +695,23 @@ func (tx *TransferTransaction) Sign(privateKey PrivateKey) *TransferTransaction
 	return tx
 }
 
+// SignWithMultiple signs the transaction with every given key, freezing it first if it isn't frozen yet.
+// Keys which already signed the transaction, including repeats within keys, are skipped. If the transaction
+// can't be frozen yet, e.g. because it has no transaction ID, the keys still sign it once it is frozen.
+func (tx *TransferTransaction) SignWithMultiple(keys []PrivateKey) *TransferTransaction {
+	if !tx.IsFrozen() {
+		_, _ = tx.Freeze()
+	}
+
+	for _, key := range keys {
+		if !tx._KeyAlreadySigned(key.PublicKey()) {
+			tx.Transaction.SignWith(key.PublicKey(), key.Sign)
+		}
+	}
+
+	return tx
+}
+
 // SignWithOperator signs the transaction with client's operator privateKey.
 func (tx *TransferTransaction) SignWithOperator(client *Client) (*TransferTransaction, error) {
 	_, err := tx.Transaction.signWithOperator(client, tx)
@@ -470,6 +731,19 @@ func (tx *TransferTransaction) SignWith(
 	return tx
 }
 
+// SignWithSigner adds the Signer's public key to the transaction's signers; the Signer signs each body when
+// the transaction is built.
+func (tx *TransferTransaction) SignWithSigner(signer Signer) *TransferTransaction {
+	tx.Transaction.SignWithSigner(signer)
+	return tx
+}
+
+// SetSigningKeys records the keys which are expected to sign this TransferTransaction.
+func (tx *TransferTransaction) SetSigningKeys(keys []PublicKey) *TransferTransaction {
+	tx.Transaction._SetSigningKeys(keys)
+	return tx
+}
+
 // AddSignature adds a signature to the transaction.
 func (tx *TransferTransaction) AddSignature(publicKey PublicKey, signature []byte) *TransferTransaction {
 	tx.Transaction.AddSignature(publicKey, signature)
@@ -482,10 +756,15 @@ func (tx *TransferTransaction) SetGrpcDeadline(deadline *time.Duration) *Transfe
 	return tx
 }
 
+// Freeze freezes the transaction without a client, for offline signing. SetTransactionID and SetNodeAccountIDs
+// must have been called, otherwise it fails with ErrNoClientOrTransactionID or ErrNoClientOrTransactionIDOrNodeID;
+// see Transaction for the defaults used for the remaining fields.
 func (tx *TransferTransaction) Freeze() (*TransferTransaction, error) {
 	return tx.FreezeWith(nil)
 }
 
+// FreezeWith freezes the transaction, taking the transaction ID, node account IDs, max fee and valid duration from
+// client where they have not been set. client may be nil, in which case it behaves like Freeze.
 func (tx *TransferTransaction) FreezeWith(client *Client) (*TransferTransaction, error) {
 	_, err := tx.Transaction.freezeWith(client, tx)
 	return tx, err
@@ -549,6 +828,12 @@ func (tx *TransferTransaction) SetMaxBackoff(max time.Duration) *TransferTransac
 	return tx
 }
 
+// SetSingleNode keeps every attempt on the same node instead of failing over to the next one.
+func (tx *TransferTransaction) SetSingleNode(singleNode bool) *TransferTransaction {
+	tx.Transaction.SetSingleNode(singleNode)
+	return tx
+}
+
 // SetMinBackoff sets the minimum amount of time to wait between retries.
 func (tx *TransferTransaction) SetMinBackoff(min time.Duration) *TransferTransaction {
 	tx.Transaction.SetMinBackoff(min)
@@ -561,9 +846,39 @@ func (tx *TransferTransaction) SetLogLevel(level LogLevel) *TransferTransaction
 }
 
 func (tx *TransferTransaction) Execute(client *Client) (TransactionResponse, error) {
+	if tx.verifyTokenDecimals && client != nil {
+		if err := tx.VerifyTokenDecimals(client); err != nil {
+			return TransactionResponse{}, err
+		}
+	}
+
 	return tx.Transaction.execute(client, tx)
 }
 
+// SignAndExecute freezes the transaction with the client unless it is already frozen, signs it with every given
+// key and the client's operator, and executes it.
+func (tx *TransferTransaction) SignAndExecute(client *Client, keys ...PrivateKey) (TransactionResponse, error) {
+	if client == nil {
+		return TransactionResponse{}, ErrNoClientProvided
+	}
+
+	if !tx.IsFrozen() {
+		if _, err := tx.FreezeWith(client); err != nil {
+			return TransactionResponse{}, err
+		}
+	}
+
+	for _, key := range keys {
+		tx.Sign(key)
+	}
+
+	if client.operator != nil {
+		tx._SignWithOperator(client.operator)
+	}
+
+	return tx.Execute(client)
+}
+
 func (tx *TransferTransaction) Schedule() (*ScheduleCreateTransaction, error) {
 	return tx.Transaction.schedule(tx)
 }
@@ -574,46 +889,175 @@ func (tx *TransferTransaction) getName() string {
 	return "TransferTransaction"
 }
 
+// _ValidateNftTransfers checks that no NFT is transferred twice and that no NFT is transferred to its sender,
+// either of which the network would reject.
+func (tx *TransferTransaction) _ValidateNftTransfers() error {
+	seen := make(map[NftID]struct{})
+	for tokenID, nftTransfers := range tx.nftTransfers {
+		for _, nftTransfer := range nftTransfers {
+			nftID := NftID{TokenID: tokenID, SerialNumber: nftTransfer.SerialNumber}
+			if _, ok := seen[nftID]; ok {
+				return ErrDuplicateNftTransfer{NftID: nftID}
+			}
+			seen[nftID] = struct{}{}
+
+			if nftTransfer.SenderAccountID.Compare(nftTransfer.ReceiverAccountID) == 0 {
+				return ErrNftTransferToSelf{NftID: nftID, AccountID: nftTransfer.SenderAccountID}
+			}
+		}
+	}
+
+	return nil
+}
+
+// _ValidateHbarTransfers checks that the hbar transfers sum to zero.
+func (tx *TransferTransaction) _ValidateHbarTransfers() error {
+	var net int64
+	for _, transfer := range tx.hbarTransfers {
+		net += transfer.Amount.AsTinybar()
+	}
+
+	if net != 0 {
+		return ErrHbarTransfersUnbalanced{Imbalance: HbarFromTinybar(net)}
+	}
+
+	return nil
+}
+
+func (tx *TransferTransaction) validateBeforeFreeze(client *Client) error {
+	if err := tx._ValidateNftTransfers(); err != nil {
+		return err
+	}
+
+	if tx.validateTransfers {
+		return tx._ValidateHbarTransfers()
+	}
+
+	return nil
+}
+
 func (tx *TransferTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
 	}
-	var err error
+
+	if errs := tx._ChecksumErrors(client, true); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// _ChecksumErrors validates the checksum of every account and token ID in the transfers against the client's
+// network, returning every failure. IDs without a checksum only fail if requireChecksums is set.
+func (tx *TransferTransaction) _ChecksumErrors(client *Client, requireChecksums bool) []error {
+	errs := make([]error, 0)
+	appendErr := func(err error) {
+		if err != nil && (requireChecksums || !errors.Is(err, ErrChecksumMissing)) {
+			errs = append(errs, err)
+		}
+	}
+
 	for token, tokenTransfer := range tx.tokenTransfers {
-		err = token.ValidateChecksum(client)
+		appendErr(token.ValidateChecksum(client))
 		for _, transfer := range tokenTransfer.Transfers {
-			err = transfer.accountID.ValidateChecksum(client)
-			if err != nil {
-				return err
-			}
-		}
-		if err != nil {
-			return err
+			appendErr(transfer.accountID.ValidateChecksum(client))
 		}
 	}
 	for token, nftTransfers := range tx.nftTransfers {
-		err = token.ValidateChecksum(client)
-		if err != nil {
-			return err
-		}
+		appendErr(token.ValidateChecksum(client))
 		for _, nftTransfer := range nftTransfers {
-			err = nftTransfer.SenderAccountID.ValidateChecksum(client)
-			if err != nil {
-				return err
-			}
-			err = nftTransfer.ReceiverAccountID.ValidateChecksum(client)
-			if err != nil {
-				return err
-			}
+			appendErr(nftTransfer.SenderAccountID.ValidateChecksum(client))
+			appendErr(nftTransfer.ReceiverAccountID.ValidateChecksum(client))
 		}
 	}
 	for _, hbarTransfer := range tx.hbarTransfers {
-		err = hbarTransfer.accountID.ValidateChecksum(client)
-		if err != nil {
-			return err
+		appendErr(hbarTransfer.accountID.ValidateChecksum(client))
+	}
+
+	return errs
+}
+
+// ValidateAll runs every pre-flight check without stopping at the first failure, so all problems with a
+// transfer can be fixed at once: NFT transfers, hbar transfers summing to zero, the number of transfers and
+// the memo length. With a client it also checks ID checksums, which are required only if the client validates
+// them, and whether the payer can cover its debit and the max transaction fee. The payer's balance is queried
+// from the network. It returns nil if every check passes.
+func (tx *TransferTransaction) ValidateAll(client *Client) []error {
+	errs := make([]error, 0)
+
+	if err := tx._ValidateNftTransfers(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := tx._ValidateHbarTransfers(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(tx.hbarTransfers) > maxHbarTransfers {
+		errs = append(errs, ErrTooManyTransfers{Kind: "hbar", Count: len(tx.hbarTransfers), MaxCount: maxHbarTransfers})
+	}
+
+	tokenTransferCount := 0
+	for _, tokenTransfer := range tx.tokenTransfers {
+		tokenTransferCount += len(tokenTransfer.Transfers)
+	}
+	for _, nftTransfers := range tx.nftTransfers {
+		tokenTransferCount += len(nftTransfers)
+	}
+	if tokenTransferCount > maxTokenTransfers {
+		errs = append(errs, ErrTooManyTransfers{Kind: "token", Count: tokenTransferCount, MaxCount: maxTokenTransfers})
+	}
+
+	if err := tx._ValidateMemo(client); err != nil {
+		errs = append(errs, err)
+	}
+
+	if client != nil {
+		errs = append(errs, tx._ChecksumErrors(client, client.autoValidateChecksums)...)
+
+		if err := tx._ValidatePayerBalance(client); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// _ValidatePayerBalance checks that the payer's balance covers its hbar debit plus the max transaction fee.
+func (tx *TransferTransaction) _ValidatePayerBalance(client *Client) error {
+	var payer AccountID
+	switch {
+	case tx.transactionIDs._Length() > 0 && tx.GetTransactionID().AccountID != nil:
+		payer = *tx.GetTransactionID().AccountID
+	case client.operator != nil:
+		payer = client.operator.accountID
+	default:
+		return ErrNoOperator
+	}
+
+	required := tx._MaxFee(client, tx.getName()).AsTinybar()
+	for _, transfer := range tx.hbarTransfers {
+		if transfer.accountID.Compare(payer) == 0 && transfer.Amount.AsTinybar() < 0 {
+			required -= transfer.Amount.AsTinybar()
 		}
 	}
 
+	balance, err := NewAccountBalanceQuery().
+		SetAccountID(payer).
+		Execute(client)
+	if err != nil {
+		return err
+	}
+
+	if balance.Hbars.AsTinybar() < required {
+		return ErrInsufficientPayerBalance{AccountID: payer, Balance: balance.Hbars, Required: HbarFromTinybar(required)}
+	}
+
 	return nil
 }
 
@@ -24,11 +24,13 @@ package hedera
  */
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 func TestUnitTransferTransactionSetTokenTransferWithDecimals(t *testing.T) {
@@ -124,7 +126,7 @@ func TestUnitTransferTransactionOrdered(t *testing.T) {
 
 	transferTransaction, err := NewTransferTransaction().
 		AddNftTransfer(tokenID1.Nft(serialNum1), accountID1, accountID2).
-		AddNftTransfer(tokenID1.Nft(serialNum1), accountID1, accountID2).
+		AddNftTransfer(tokenID1.Nft(serialNum1+1), accountID1, accountID2).
 		SetTransactionID(NewTransactionIDWithValidStart(AccountID{Shard: 3, Realm: 3, Account: 3, checksum: nil}, time.Unix(4, 4))).
 		SetNodeAccountIDs([]AccountID{accountID4}).
 		Freeze()
@@ -222,3 +224,568 @@ func TestUnitTransferTransactionOrdered(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitNewSweepHbarTransaction(t *testing.T) {
+	t.Parallel()
+
+	balance := func(tinybars uint64) *services.Response {
+		return &services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					Balance: tinybars,
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{balance(10_0000_0000), balance(5000)}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	source := AccountID{Account: 1234}
+	destination := AccountID{Account: 5678}
+
+	sweep, err := NewSweepHbarTransaction(client, source, destination, HbarFromTinybar(2_500_000))
+	require.NoError(t, err)
+	require.Equal(t, map[AccountID]Hbar{
+		source:      HbarFromTinybar(-997_500_000),
+		destination: HbarFromTinybar(997_500_000),
+	}, sweep.GetHbarTransfers())
+	require.Equal(t, HbarFromTinybar(2_500_000), sweep.GetMaxTransactionFee())
+	require.Equal(t, source, *sweep.GetTransactionID().AccountID)
+
+	_, err = NewSweepHbarTransaction(client, source, destination, HbarFromTinybar(5000))
+	var balanceErr ErrInsufficientBalanceForFee
+	require.ErrorAs(t, err, &balanceErr)
+	require.Equal(t, HbarFromTinybar(5000), balanceErr.Balance)
+}
+
+func TestUnitTransferTransactionFromSchedulableBodyBytes(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 5005}
+	body := &services.SchedulableTransactionBody{
+		TransactionFee: 100000000,
+		Memo:           "scheduled transfer",
+		Data: &services.SchedulableTransactionBody_CryptoTransfer{
+			CryptoTransfer: &services.CryptoTransferTransactionBody{
+				Transfers: &services.TransferList{
+					AccountAmounts: []*services.AccountAmount{
+						{AccountID: AccountID{Account: 1800}._ToProtobuf(), Amount: -100},
+						{AccountID: AccountID{Account: 1234}._ToProtobuf(), Amount: 100},
+					},
+				},
+				TokenTransfers: []*services.TokenTransferList{
+					{
+						Token: tokenID._ToProtobuf(),
+						Transfers: []*services.AccountAmount{
+							{AccountID: AccountID{Account: 1800}._ToProtobuf(), Amount: -7},
+							{AccountID: AccountID{Account: 1234}._ToProtobuf(), Amount: 7},
+						},
+					},
+				},
+			},
+		},
+	}
+	data, err := protobuf.Marshal(body)
+	require.NoError(t, err)
+
+	transfer, err := TransferTransactionFromSchedulableBodyBytes(data)
+	require.NoError(t, err)
+	require.True(t, transfer.IsFrozen())
+	require.Equal(t, "scheduled transfer", transfer.GetTransactionMemo())
+	require.Equal(t, HbarFromTinybar(100000000), transfer.GetMaxTransactionFee())
+	require.Equal(t, map[AccountID]Hbar{
+		{Account: 1800}: HbarFromTinybar(-100),
+		{Account: 1234}: HbarFromTinybar(100),
+	}, transfer.GetHbarTransfers())
+	require.ElementsMatch(t, []TokenTransfer{
+		{AccountID: AccountID{Account: 1800}, Amount: -7},
+		{AccountID: AccountID{Account: 1234}, Amount: 7},
+	}, transfer.GetTokenTransfers()[tokenID])
+
+	data, err = protobuf.Marshal(&services.SchedulableTransactionBody{
+		Data: &services.SchedulableTransactionBody_CryptoDelete{
+			CryptoDelete: &services.CryptoDeleteTransactionBody{},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = TransferTransactionFromSchedulableBodyBytes(data)
+	require.ErrorIs(t, err, ErrSchedulableBodyNotTransfer)
+}
+
+func TestUnitTransferTransactionGetHbarTransfersAggregates(t *testing.T) {
+	t.Parallel()
+
+	body := &services.SchedulableTransactionBody{
+		Data: &services.SchedulableTransactionBody_CryptoTransfer{
+			CryptoTransfer: &services.CryptoTransferTransactionBody{
+				Transfers: &services.TransferList{
+					AccountAmounts: []*services.AccountAmount{
+						{AccountID: AccountID{Account: 1800}._ToProtobuf(), Amount: -100},
+						{AccountID: AccountID{Account: 1234}._ToProtobuf(), Amount: 150},
+						{AccountID: AccountID{Account: 1800}._ToProtobuf(), Amount: -50},
+					},
+				},
+			},
+		},
+	}
+	data, err := protobuf.Marshal(body)
+	require.NoError(t, err)
+
+	transfer, err := TransferTransactionFromSchedulableBodyBytes(data)
+	require.NoError(t, err)
+	require.Equal(t, map[AccountID]Hbar{
+		{Account: 1800}: HbarFromTinybar(-150),
+		{Account: 1234}: HbarFromTinybar(150),
+	}, transfer.GetHbarTransfers())
+
+	built := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(10)).
+		AddHbarTransfer(AccountID{Account: 4}, HbarFromTinybar(-10)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(-4))
+	require.Equal(t, map[AccountID]Hbar{
+		{Account: 3}: HbarFromTinybar(6),
+		{Account: 4}: HbarFromTinybar(-10),
+	}, built.GetHbarTransfers())
+}
+
+func TestUnitTransferTransactionString(t *testing.T) {
+	t.Parallel()
+
+	transfer := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 4}, NewHbar(5)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(-5))
+	require.Equal(t, "0.0.3:-5ℏ, 0.0.4:+5ℏ", transfer.String())
+
+	transfer.
+		AddTokenTransfer(TokenID{Token: 5005}, AccountID{Account: 4}, 7).
+		AddTokenTransfer(TokenID{Token: 5005}, AccountID{Account: 3}, -7).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 6006}, SerialNumber: 2}, AccountID{Account: 3}, AccountID{Account: 4}).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 6006}, SerialNumber: 1}, AccountID{Account: 4}, AccountID{Account: 3})
+	require.Equal(t, "0.0.3:-5ℏ, 0.0.4:+5ℏ; 0.0.5005[0.0.3:-7, 0.0.4:+7]; 0.0.6006#2[0.0.3->0.0.4]; 0.0.6006#1[0.0.4->0.0.3]", transfer.String())
+}
+
+func TestUnitTransferTransactionDuplicateNftSerial(t *testing.T) {
+	t.Parallel()
+
+	nftID := NftID{TokenID: TokenID{Token: 6006}, SerialNumber: 1}
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddNftTransfer(nftID, AccountID{Account: 1800}, AccountID{Account: 1234}).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 6006}, SerialNumber: 2}, AccountID{Account: 1800}, AccountID{Account: 1234}).
+		AddNftTransfer(nftID, AccountID{Account: 1234}, AccountID{Account: 5678}).
+		Freeze()
+	var duplicateErr ErrDuplicateNftTransfer
+	require.ErrorAs(t, err, &duplicateErr)
+	require.Equal(t, nftID, duplicateErr.NftID)
+
+	_, err = NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddNftTransfer(nftID, AccountID{Account: 1800}, AccountID{Account: 1234}).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 7007}, SerialNumber: 1}, AccountID{Account: 1800}, AccountID{Account: 1234}).
+		Freeze()
+	require.NoError(t, err)
+}
+
+func TestUnitTransferTransactionNftTransfersOfSameToken(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 6006}
+	transfer := NewTransferTransaction().
+		AddNftTransfer(tokenID.Nft(1), AccountID{Account: 1800}, AccountID{Account: 1234}).
+		AddNftTransfer(tokenID.Nft(2), AccountID{Account: 1800}, AccountID{Account: 1235})
+
+	require.Equal(t, map[TokenID][]TokenNftTransfer{
+		tokenID: {
+			{SenderAccountID: AccountID{Account: 1800}, ReceiverAccountID: AccountID{Account: 1234}, SerialNumber: 1},
+			{SenderAccountID: AccountID{Account: 1800}, ReceiverAccountID: AccountID{Account: 1235}, SerialNumber: 2},
+		},
+	}, transfer.GetNftTransfers())
+
+	body := transfer.buildProtoBody()
+	require.Len(t, body.TokenTransfers, 1)
+	require.Len(t, body.TokenTransfers[0].NftTransfers, 2)
+}
+
+func TestUnitTransferTransactionNftToSameAccount(t *testing.T) {
+	t.Parallel()
+
+	nftID := NftID{TokenID: TokenID{Token: 6006}, SerialNumber: 1}
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddNftTransfer(nftID, AccountID{Account: 1800}, AccountID{Account: 1800}).
+		Freeze()
+	var selfErr ErrNftTransferToSelf
+	require.ErrorAs(t, err, &selfErr)
+	require.Equal(t, nftID, selfErr.NftID)
+	require.Equal(t, AccountID{Account: 1800}, selfErr.AccountID)
+}
+
+func TestUnitTransferTransactionValidateTransfers(t *testing.T) {
+	t.Parallel()
+
+	newTransfer := func() *TransferTransaction {
+		return NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+			AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-100)).
+			AddHbarTransfer(AccountID{Account: 1234}, HbarFromTinybar(90))
+	}
+
+	_, err := newTransfer().Freeze()
+	require.NoError(t, err)
+
+	transfer := newTransfer().SetValidateTransfers(true)
+	require.True(t, transfer.GetValidateTransfers())
+	_, err = transfer.Freeze()
+	var unbalancedErr ErrHbarTransfersUnbalanced
+	require.ErrorAs(t, err, &unbalancedErr)
+	require.Equal(t, HbarFromTinybar(-10), unbalancedErr.Imbalance)
+	require.Contains(t, err.Error(), "-10 tinybar")
+
+	_, err = newTransfer().
+		SetValidateTransfers(true).
+		AddHbarTransfer(AccountID{Account: 1234}, HbarFromTinybar(10)).
+		Freeze()
+	require.NoError(t, err)
+}
+
+func TestUnitTransferTransactionAddHbarTransferFromString(t *testing.T) {
+	t.Parallel()
+
+	transfer, err := NewTransferTransaction().AddHbarTransferFromString(AccountID{Account: 1800}, "-1.25")
+	require.NoError(t, err)
+	_, err = transfer.AddHbarTransferFromString(AccountID{Account: 1234}, "1.25000000")
+	require.NoError(t, err)
+	_, err = transfer.AddHbarTransferFromString(AccountID{Account: 1234}, "+0.00000001")
+	require.NoError(t, err)
+	require.Equal(t, map[AccountID]Hbar{
+		{Account: 1800}: HbarFromTinybar(-125_000_000),
+		{Account: 1234}: HbarFromTinybar(125_000_001),
+	}, transfer.GetHbarTransfers())
+
+	for _, amount := range []string{"", "1.", ".5", "1,5", "1 ℏ", "abc", "0.000000001", "92233720368.54775808"} {
+		_, err = transfer.AddHbarTransferFromString(AccountID{Account: 1234}, amount)
+		var amountErr ErrInvalidHbarAmount
+		require.ErrorAs(t, err, &amountErr, amount)
+		require.Equal(t, amount, amountErr.Amount)
+	}
+
+	require.Len(t, transfer.GetHbarTransfers(), 2)
+}
+
+func TestUnitTransferTransactionValidateAll(t *testing.T) {
+	t.Parallel()
+
+	balance := func(request *services.Query) *services.Response {
+		query := request.Query.(*services.Query_CryptogetAccountBalance).CryptogetAccountBalance
+		return &services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: query.Header.ResponseType},
+					AccountID: query.GetAccountID(),
+					Balance:   50,
+				},
+			},
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{balance, balance}})
+	defer server.Close()
+
+	badChecksum, err := AccountIDFromString("0.0.123-aaaaa")
+	require.NoError(t, err)
+
+	payer := AccountID{Account: 1800}
+	transfer := NewTransferTransaction().
+		SetTransactionID(TransactionIDGenerate(payer)).
+		SetMaxTransactionFee(HbarFromTinybar(100)).
+		SetTransactionMemo(strings.Repeat("a", defaultMaxTransactionMemoBytes+1)).
+		AddHbarTransfer(payer, HbarFromTinybar(-100)).
+		AddHbarTransfer(badChecksum, HbarFromTinybar(1))
+	for i := 0; i < maxHbarTransfers; i++ {
+		transfer.AddHbarTransfer(AccountID{Account: uint64(2000 + i)}, HbarFromTinybar(1))
+	}
+
+	errs := transfer.ValidateAll(client)
+	require.Len(t, errs, 5)
+
+	var unbalancedErr ErrHbarTransfersUnbalanced
+	require.ErrorAs(t, errs[0], &unbalancedErr)
+	require.Equal(t, HbarFromTinybar(-89), unbalancedErr.Imbalance)
+	require.Equal(t, ErrTooManyTransfers{Kind: "hbar", Count: maxHbarTransfers + 2, MaxCount: maxHbarTransfers}, errs[1])
+	require.Equal(t, ErrMemoTooLong{Length: defaultMaxTransactionMemoBytes + 1, MaxLength: defaultMaxTransactionMemoBytes}, errs[2])
+	require.Contains(t, errs[3].Error(), "wrong checksum")
+	require.Equal(t, ErrInsufficientPayerBalance{AccountID: payer, Balance: HbarFromTinybar(50), Required: HbarFromTinybar(200)}, errs[4])
+
+	require.Nil(t, NewTransferTransaction().
+		AddHbarTransfer(payer, HbarFromTinybar(-10)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(10)).
+		ValidateAll(nil))
+}
+
+func TestUnitTransferTransactionConflictingDecimals(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 5005}
+
+	transfer := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddTokenTransferWithDecimals(tokenID, AccountID{Account: 1800}, -10, 2).
+		AddTokenTransferWithDecimals(tokenID, AccountID{Account: 1800}, -5, 2).
+		AddTokenTransferWithDecimals(tokenID, AccountID{Account: 1234}, 15, 2)
+	require.Equal(t, map[TokenID]uint32{tokenID: 2}, transfer.GetTokenIDDecimals())
+	require.ElementsMatch(t, []TokenTransfer{
+		{AccountID: AccountID{Account: 1800}, Amount: -15},
+		{AccountID: AccountID{Account: 1234}, Amount: 15},
+	}, transfer.GetTokenTransfers()[tokenID])
+
+	_, err := transfer.
+		AddTokenTransferWithDecimals(tokenID, AccountID{Account: 1234}, 1, 3).
+		Freeze()
+	var decimalsErr ErrTokenDecimalsMismatch
+	require.ErrorAs(t, err, &decimalsErr)
+	require.Equal(t, ErrTokenDecimalsMismatch{TokenID: tokenID, ExpectedDecimals: 2, Decimals: 3}, decimalsErr)
+	require.Equal(t, map[TokenID]uint32{tokenID: 2}, transfer.GetTokenIDDecimals())
+}
+
+func TestUnitTransferTransactionSignAndExecute(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]PrivateKey, 2)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+
+	var signers []string
+	call := func(request *services.Transaction) *services.TransactionResponse {
+		signedTransaction := services.SignedTransaction{}
+		require.NoError(t, protobuf.Unmarshal(request.SignedTransactionBytes, &signedTransaction))
+
+		for _, sigPair := range signedTransaction.GetSigMap().GetSigPair() {
+			key, err := PublicKeyFromBytes(sigPair.PubKeyPrefix)
+			require.NoError(t, err)
+			require.True(t, key.Verify(signedTransaction.BodyBytes, sigPair.GetEd25519()))
+			signers = append(signers, key.String())
+		}
+
+		return &services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call}})
+	defer server.Close()
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		SignAndExecute(client, keys...)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []string{
+		keys[0].PublicKey().String(),
+		keys[1].PublicKey().String(),
+		client.GetOperatorPublicKey().String(),
+	}, signers)
+}
+
+func TestUnitTransferTransactionSignWithMultiple(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]PrivateKey, 3)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+
+	transaction := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 5})).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-2)).
+		AddHbarTransfer(AccountID{Account: 6}, NewHbar(1)).
+		AddHbarTransfer(AccountID{Account: 7}, NewHbar(1)).
+		Sign(keys[0])
+	transaction.SignWithMultiple([]PrivateKey{keys[0], keys[1], keys[2], keys[1]})
+	require.True(t, transaction.IsFrozen())
+
+	_, err := transaction.ToBytes()
+	require.NoError(t, err)
+
+	signatures, err := transaction.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures, 2)
+	for _, nodeSignatures := range signatures {
+		signers := make([]string, 0)
+		for publicKey := range nodeSignatures {
+			signers = append(signers, publicKey.String())
+		}
+		require.ElementsMatch(t, []string{
+			keys[0].PublicKey().String(),
+			keys[1].PublicKey().String(),
+			keys[2].PublicKey().String(),
+		}, signers)
+	}
+}
+
+func TestUnitTransferTransactionVerifyTokenDecimals(t *testing.T) {
+	t.Parallel()
+
+	tokenInfo := func(request *services.Query) *services.Response {
+		query := request.Query.(*services.Query_TokenGetInfo).TokenGetInfo
+		if query.Header.ResponseType == services.ResponseType_COST_ANSWER {
+			return &services.Response{
+				Response: &services.Response_TokenGetInfo{
+					TokenGetInfo: &services.TokenGetInfoResponse{
+						Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER, Cost: 2},
+					},
+				},
+			}
+		}
+
+		return &services.Response{
+			Response: &services.Response_TokenGetInfo{
+				TokenGetInfo: &services.TokenGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					TokenInfo: &services.TokenInfo{
+						TokenId:  query.Token,
+						Decimals: 2,
+					},
+				},
+			},
+		}
+	}
+
+	transfer := func(request *services.Transaction) *services.TransactionResponse {
+		return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{tokenInfo, tokenInfo, tokenInfo, tokenInfo, tokenInfo, tokenInfo, transfer}})
+	defer server.Close()
+
+	tokenID := TokenID{Token: 100}
+	newTransaction := func(decimals uint32) *TransferTransaction {
+		return NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetVerifyTokenDecimals(true).
+			AddTokenTransferWithDecimals(tokenID, AccountID{Account: 5}, -10, decimals).
+			AddTokenTransferWithDecimals(tokenID, AccountID{Account: 6}, 10, decimals)
+	}
+
+	// Freezing stays offline, so the mismatch is only found by the explicit check or by Execute.
+	transaction, err := newTransaction(3).FreezeWith(client)
+	require.NoError(t, err)
+	require.True(t, transaction.GetVerifyTokenDecimals())
+
+	err = transaction.VerifyTokenDecimals(client)
+	require.ErrorIs(t, err, ErrTokenDecimalsIncorrect{TokenID: tokenID, ExpectedDecimals: 3, Decimals: 2})
+
+	_, err = newTransaction(3).Execute(client)
+	require.ErrorIs(t, err, ErrTokenDecimalsIncorrect{TokenID: tokenID, ExpectedDecimals: 3, Decimals: 2})
+
+	_, err = newTransaction(2).Execute(client)
+	require.NoError(t, err)
+}
+
+func TestUnitTransferTransactionFreezeOfflineSignLater(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	nodeAccountIDs := []AccountID{{Account: 3}, {Account: 4}}
+	tx, err := NewTransferTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetNodeAccountIDs(nodeAccountIDs).
+		SetMaxTransactionFee(NewHbar(2)).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, NewHbar(1)).
+		FreezeWith(nil)
+	require.NoError(t, err)
+
+	unsigned, err := tx.ToBytes()
+	require.NoError(t, err)
+
+	decoded, err := TransactionFromBytes(unsigned)
+	require.NoError(t, err)
+	transfer := decoded.(TransferTransaction)
+	require.Equal(t, NewHbar(2), transfer.GetMaxTransactionFee())
+	transfer.Sign(key)
+
+	signed, err := transfer.ToBytes()
+	require.NoError(t, err)
+	decoded, err = TransactionFromBytes(signed)
+	require.NoError(t, err)
+	transfer = decoded.(TransferTransaction)
+
+	signatures, err := transfer.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures, len(nodeAccountIDs))
+	for _, nodeAccountID := range nodeAccountIDs {
+		require.Len(t, signatures[nodeAccountID], 1)
+	}
+
+	require.NotPanics(t, func() {
+		_, err = NewTransferTransaction().FreezeWith(nil)
+	})
+	require.ErrorIs(t, err, ErrNoClientOrTransactionID)
+}
+
+func TestUnitTransferTransactionBytesCoSigners(t *testing.T) {
+	t.Parallel()
+
+	nodeAccountIDs := []AccountID{{Account: 3}, {Account: 4}}
+	tx, err := NewTransferTransaction().
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetNodeAccountIDs(nodeAccountIDs).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-2)).
+		AddHbarTransfer(AccountID{Account: 1801}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 98}, NewHbar(3)).
+		Freeze()
+	require.NoError(t, err)
+
+	data, err := tx.ToBytes()
+	require.NoError(t, err)
+
+	signers := make([]PublicKey, 0)
+	for i := 0; i < 2; i++ {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		signers = append(signers, key.PublicKey())
+
+		decoded, err := TransactionFromBytes(data)
+		require.NoError(t, err)
+		transfer, ok := decoded.(TransferTransaction)
+		require.True(t, ok)
+		data, err = transfer.Sign(key).ToBytes()
+		require.NoError(t, err)
+	}
+
+	decoded, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+	transfer := decoded.(TransferTransaction)
+	require.Equal(t, tx.GetHbarTransfers(), transfer.GetHbarTransfers())
+
+	signatures, err := transfer.GetSignatures()
+	require.NoError(t, err)
+	for _, nodeAccountID := range nodeAccountIDs {
+		require.Len(t, signatures[nodeAccountID], len(signers))
+		for publicKey, signature := range signatures[nodeAccountID] {
+			require.Contains(t, []string{signers[0].String(), signers[1].String()}, publicKey.String())
+			require.NotEmpty(t, signature)
+		}
+	}
+}